@@ -0,0 +1,133 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"fmt"
+	"github.com/nwillc/genfuncs"
+	"iter"
+)
+
+// BiMap implements Map.
+var _ Map[int, string] = (*BiMap[int, string])(nil)
+
+// BiMap is a Map that enforces the uniqueness of its values as well as its keys, allowing lookup of keys by value
+// through its Inverse. BiMap implements Map.
+type BiMap[K comparable, V comparable] struct {
+	forward  GMap[K, V]
+	backward GMap[V, K]
+	inverse  *BiMap[V, K]
+}
+
+// NewBiMap creates a new empty BiMap.
+func NewBiMap[K comparable, V comparable]() (biMap *BiMap[K, V]) {
+	biMap = &BiMap[K, V]{
+		forward:  make(GMap[K, V]),
+		backward: make(GMap[V, K]),
+	}
+	return biMap
+}
+
+// All returns an iter.Seq2 of the keys and values of the BiMap.
+func (b *BiMap[K, V]) All() (seq iter.Seq2[K, V]) {
+	seq = b.forward.All()
+	return seq
+}
+
+// Contains returns true if the BiMap contains the given key.
+func (b *BiMap[K, V]) Contains(key K) (contains bool) {
+	contains = b.forward.Contains(key)
+	return contains
+}
+
+// ContainsValue returns true if the BiMap contains the given value.
+func (b *BiMap[K, V]) ContainsValue(value V) (contains bool) {
+	contains = b.backward.Contains(value)
+	return contains
+}
+
+// Delete the entry for the key from the BiMap.
+func (b *BiMap[K, V]) Delete(key K) {
+	if value, ok := b.forward[key]; ok {
+		delete(b.forward, key)
+		delete(b.backward, value)
+	}
+}
+
+// ForcePut puts the key value pair in the BiMap, first deleting any entry with the value.
+func (b *BiMap[K, V]) ForcePut(key K, value V) {
+	b.Inverse().Delete(value)
+	b.put(key, value)
+}
+
+// ForEach traverses the BiMap applying the given function to all entries.
+func (b *BiMap[K, V]) ForEach(f func(key K, value V)) {
+	b.forward.ForEach(f)
+}
+
+// Get the value for the key.
+func (b *BiMap[K, V]) Get(key K) (value V, ok bool) {
+	value, ok = b.forward.Get(key)
+	return value, ok
+}
+
+// Inverse returns a view of the BiMap mapping values to keys. Changes to either are visible in the other.
+func (b *BiMap[K, V]) Inverse() (inverse *BiMap[V, K]) {
+	if b.inverse == nil {
+		b.inverse = &BiMap[V, K]{forward: b.backward, backward: b.forward, inverse: b}
+	}
+	inverse = b.inverse
+	return inverse
+}
+
+// Iterator returns an Iterator over the values of the BiMap.
+func (b *BiMap[K, V]) Iterator() Iterator[V] {
+	return b.forward.Iterator()
+}
+
+// Keys returns the keys of the BiMap.
+func (b *BiMap[K, V]) Keys() (keys GSlice[K]) {
+	keys = b.forward.Keys()
+	return keys
+}
+
+// Len returns the number of entries in the BiMap.
+func (b *BiMap[K, V]) Len() (length int) {
+	length = b.forward.Len()
+	return length
+}
+
+// Put the key value pair in the BiMap, replacing any value for the key. Put panics with IllegalArguments if the value
+// is already bound to a different key, use ForcePut to replace that binding.
+func (b *BiMap[K, V]) Put(key K, value V) {
+	if k, ok := b.backward[value]; ok && k != key {
+		panic(fmt.Errorf("%w: value %v already bound to key %v", genfuncs.IllegalArguments, value, k))
+	}
+	b.put(key, value)
+}
+
+// Values returns the values of the BiMap.
+func (b *BiMap[K, V]) Values() (values GSlice[V]) {
+	values = b.forward.Values()
+	return values
+}
+
+func (b *BiMap[K, V]) put(key K, value V) {
+	b.Delete(key)
+	b.forward[key] = value
+	b.backward[value] = key
+}
@@ -0,0 +1,82 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewBiMap(t *testing.T) {
+	m := container.NewBiMap[string, int]()
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, 0, m.Inverse().Len())
+	assert.False(t, m.Iterator().HasNext())
+	assert.Same(t, m, m.Inverse().Inverse())
+}
+
+func TestBiMap_Put(t *testing.T) {
+	m := container.NewBiMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("a", 3)
+	assert.Equal(t, 2, m.Len())
+	assert.False(t, m.ContainsValue(1))
+	v, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+	k, ok := m.Inverse().Get(3)
+	assert.True(t, ok)
+	assert.Equal(t, "a", k)
+	assert.PanicsWithError(t, genfuncs.IllegalArguments.Error()+": value 2 already bound to key b", func() {
+		m.Put("c", 2)
+	})
+	m.ForcePut("c", 2)
+	assert.False(t, m.Contains("b"))
+	assert.ElementsMatch(t, []string{"a", "c"}, m.Keys())
+	assert.ElementsMatch(t, []int{2, 3}, m.Values())
+	assert.ElementsMatch(t, []string{"a", "c"}, m.Inverse().Values())
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	m := container.NewBiMap[string, int]()
+	inverse := m.Inverse()
+	inverse.Put(1, "a")
+	assert.True(t, m.Contains("a"))
+	m.Put("b", 2)
+	assert.True(t, inverse.Contains(2))
+	inverse.Delete(1)
+	assert.False(t, m.Contains("a"))
+	m.Delete("b")
+	assert.Equal(t, 0, inverse.Len())
+}
+
+func TestBiMap_Traverse(t *testing.T) {
+	m := container.NewBiMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	sum := 0
+	m.ForEach(func(_ string, v int) { sum += v })
+	for k, v := range m.All() {
+		assert.True(t, m.Inverse().ContainsValue(k))
+		sum += v
+	}
+	assert.Equal(t, 6, sum)
+}
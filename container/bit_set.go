@@ -0,0 +1,290 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"github.com/nwillc/genfuncs"
+	"golang.org/x/exp/constraints"
+	"iter"
+	"math"
+	"math/bits"
+)
+
+var (
+	// BitSet implements Set.
+	_ Set[int]                   = (*BitSet[int])(nil)
+	_ Iterator[int]              = (*bitSetIterator[int])(nil)
+	_ encoding.BinaryMarshaler   = (*BitSet[int])(nil)
+	_ encoding.BinaryUnmarshaler = (*BitSet[int])(nil)
+)
+
+const (
+	// BitSetMaxValue is the largest value a BitSet can contain.
+	BitSetMaxValue = math.MaxInt32
+	bitSetWordSize = 64
+)
+
+type (
+	// BitSet is a Set of non-negative integers packed as bits in a slice of words, suited to dense sets of small
+	// values. Adding a negative value, or one greater than BitSetMaxValue, panics with IllegalArguments. BitSet
+	// implements Set.
+	BitSet[T constraints.Integer] struct {
+		words []uint64
+	}
+	bitSetIterator[T constraints.Integer] struct {
+		set  *BitSet[T]
+		word int
+		bits uint64
+	}
+)
+
+// NewBitSet returns a new BitSet containing the given values.
+func NewBitSet[T constraints.Integer](t ...T) (set *BitSet[T]) {
+	set = &BitSet[T]{}
+	set.AddAll(t...)
+	return set
+}
+
+// Add element to the BitSet.
+func (b *BitSet[T]) Add(t T) {
+	word, bit := bitSetPosition(t)
+	if word >= len(b.words) {
+		b.words = append(b.words, make([]uint64, word-len(b.words)+1)...)
+	}
+	b.words[word] |= bit
+}
+
+// AddAll elements to the BitSet.
+func (b *BitSet[T]) AddAll(t ...T) {
+	for _, e := range t {
+		b.Add(e)
+	}
+}
+
+// All returns an iter.Seq of the elements of the BitSet in ascending order.
+func (b *BitSet[T]) All() (seq iter.Seq[T]) {
+	seq = func(yield func(T) bool) {
+		for iterator := b.Iterator(); iterator.HasNext(); {
+			if !yield(iterator.Next()) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
+// Contains returns true if the BitSet contains the element.
+func (b *BitSet[T]) Contains(t T) (ok bool) {
+	if t < 0 || uint64(t) > BitSetMaxValue {
+		return false
+	}
+	word, bit := bitSetPosition(t)
+	ok = word < len(b.words) && b.words[word]&bit != 0
+	return ok
+}
+
+// Difference returns a new BitSet of the elements of the BitSet not in the other.
+func (b *BitSet[T]) Difference(other *BitSet[T]) (result *BitSet[T]) {
+	result = &BitSet[T]{words: append([]uint64{}, b.words...)}
+	for i := 0; i < min(len(b.words), len(other.words)); i++ {
+		result.words[i] &^= other.words[i]
+	}
+	result.trim()
+	return result
+}
+
+// Equal returns true if the BitSet and the other contain the same elements.
+func (b *BitSet[T]) Equal(other *BitSet[T]) (equal bool) {
+	longer, shorter := b.words, other.words
+	if len(shorter) > len(longer) {
+		longer, shorter = shorter, longer
+	}
+	for i := range longer {
+		if i < len(shorter) {
+			if longer[i] != shorter[i] {
+				return false
+			}
+		} else if longer[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersect returns a new BitSet of the elements in both the BitSet and the other.
+func (b *BitSet[T]) Intersect(other *BitSet[T]) (result *BitSet[T]) {
+	result = &BitSet[T]{words: make([]uint64, min(len(b.words), len(other.words)))}
+	for i := range result.words {
+		result.words[i] = b.words[i] & other.words[i]
+	}
+	result.trim()
+	return result
+}
+
+// IsSubsetOf returns true if every element of the BitSet is in the other.
+func (b *BitSet[T]) IsSubsetOf(other *BitSet[T]) (subset bool) {
+	for i, w := range b.words {
+		var o uint64
+		if i < len(other.words) {
+			o = other.words[i]
+		}
+		if w&^o != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Iterator returns an Iterator over the elements of the BitSet in ascending order.
+func (b *BitSet[T]) Iterator() Iterator[T] {
+	iterator := &bitSetIterator[T]{set: b, word: -1}
+	iterator.advance()
+	return iterator
+}
+
+// Len returns the number of elements in the BitSet.
+func (b *BitSet[T]) Len() (length int) {
+	for _, w := range b.words {
+		length += bits.OnesCount64(w)
+	}
+	return length
+}
+
+// MarshalBinary encodes the BitSet as little endian words, omitting trailing empty words.
+func (b *BitSet[T]) MarshalBinary() (data []byte, err error) {
+	words := b.words
+	for len(words) > 0 && words[len(words)-1] == 0 {
+		words = words[:len(words)-1]
+	}
+	data = make([]byte, 0, len(words)*8)
+	for _, w := range words {
+		data = binary.LittleEndian.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+// Remove an element from the BitSet.
+func (b *BitSet[T]) Remove(t T) {
+	if !b.Contains(t) {
+		return
+	}
+	word, bit := bitSetPosition(t)
+	b.words[word] &^= bit
+}
+
+// SymmetricDifference returns a new BitSet of the elements in exactly one of the BitSet and the other.
+func (b *BitSet[T]) SymmetricDifference(other *BitSet[T]) (result *BitSet[T]) {
+	result = b.combine(other, func(w1, w2 uint64) uint64 { return w1 ^ w2 })
+	return result
+}
+
+// Union returns a new BitSet of the elements in either the BitSet or the other.
+func (b *BitSet[T]) Union(other *BitSet[T]) (result *BitSet[T]) {
+	result = b.combine(other, func(w1, w2 uint64) uint64 { return w1 | w2 })
+	return result
+}
+
+// UnmarshalBinary replaces the contents of the BitSet with those decoded from data produced by MarshalBinary.
+func (b *BitSet[T]) UnmarshalBinary(data []byte) (err error) {
+	if len(data)%8 != 0 {
+		return fmt.Errorf("%w: bit set data length %d is not a multiple of 8", genfuncs.IllegalArguments, len(data))
+	}
+	if len(data)/8 > (BitSetMaxValue+1)/bitSetWordSize {
+		return fmt.Errorf("%w: bit set data length %d exceeds values of %d", genfuncs.IllegalArguments, len(data),
+			BitSetMaxValue)
+	}
+	b.words = make([]uint64, len(data)/8)
+	for i := range b.words {
+		b.words[i] = binary.LittleEndian.Uint64(data[i*8:])
+	}
+	b.trim()
+	return nil
+}
+
+// Values returns the elements of the BitSet in ascending order.
+func (b *BitSet[T]) Values() (values GSlice[T]) {
+	values = make(GSlice[T], 0, b.Len())
+	for t := range b.All() {
+		values = append(values, t)
+	}
+	return values
+}
+
+func (b *BitSet[T]) combine(other *BitSet[T], op func(w1, w2 uint64) uint64) (result *BitSet[T]) {
+	result = &BitSet[T]{words: make([]uint64, max(len(b.words), len(other.words)))}
+	for i := range result.words {
+		var w1, w2 uint64
+		if i < len(b.words) {
+			w1 = b.words[i]
+		}
+		if i < len(other.words) {
+			w2 = other.words[i]
+		}
+		result.words[i] = op(w1, w2)
+	}
+	result.trim()
+	return result
+}
+
+// trim removes trailing empty words.
+func (b *BitSet[T]) trim() {
+	end := len(b.words)
+	for end > 0 && b.words[end-1] == 0 {
+		end--
+	}
+	b.words = b.words[:end]
+}
+
+func bitSetPosition[T constraints.Integer](t T) (word int, bit uint64) {
+	if t < 0 {
+		panic(fmt.Errorf("%w: bit set value %d is negative", genfuncs.IllegalArguments, t))
+	}
+	if uint64(t) > BitSetMaxValue {
+		panic(fmt.Errorf("%w: bit set value %d is greater than %d", genfuncs.IllegalArguments, t, BitSetMaxValue))
+	}
+	word = int(uint64(t) / bitSetWordSize)
+	bit = 1 << (uint64(t) % bitSetWordSize)
+	return word, bit
+}
+
+func (i *bitSetIterator[T]) HasNext() bool {
+	return i.bits != 0
+}
+
+func (i *bitSetIterator[T]) Next() (value T) {
+	if !i.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	value = T(i.word*bitSetWordSize + bits.TrailingZeros64(i.bits))
+	i.bits &= i.bits - 1
+	if i.bits == 0 {
+		i.advance()
+	}
+	return value
+}
+
+// advance moves to the next non-empty word.
+func (i *bitSetIterator[T]) advance() {
+	for i.word++; i.word < len(i.set.words); i.word++ {
+		if i.bits = i.set.words[i.word]; i.bits != 0 {
+			return
+		}
+	}
+}
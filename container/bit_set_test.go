@@ -0,0 +1,150 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewBitSet(t *testing.T) {
+	s := container.NewBitSet[uint8]()
+	assert.Equal(t, 0, s.Len())
+	assert.False(t, s.Contains(0))
+	assert.False(t, s.Iterator().HasNext())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = s.Iterator().Next() })
+	s.AddAll(255, 0, 64)
+	assert.Equal(t, []uint8{0, 64, 255}, []uint8(s.Values()))
+	assert.PanicsWithError(t, genfuncs.IllegalArguments.Error()+": bit set value -1 is negative", func() {
+		container.NewBitSet(-1)
+	})
+	assert.False(t, container.NewBitSet(1).Contains(-1))
+	assert.PanicsWithError(t, genfuncs.IllegalArguments.Error()+": bit set value 9223372036854775807 is greater than 2147483647", func() {
+		container.NewBitSet[int64](math.MaxInt64)
+	})
+	assert.PanicsWithError(t, genfuncs.IllegalArguments.Error()+": bit set value 18446744073709551615 is greater than 2147483647", func() {
+		container.NewBitSet[uint64](math.MaxUint64)
+	})
+	assert.False(t, container.NewBitSet[uint64](1).Contains(math.MaxUint64))
+	assert.False(t, container.NewBitSet(1).Contains(container.BitSetMaxValue))
+}
+
+func TestBitSet_AddRemove(t *testing.T) {
+	random := rand.New(rand.NewSource(time.Now().Unix()))
+	s := container.NewBitSet[int]()
+	m := container.NewMapSet[int]()
+	for i := 0; i < 1000; i++ {
+		v := random.Intn(500)
+		if random.Intn(3) == 0 {
+			s.Remove(v)
+			m.Remove(v)
+		} else {
+			s.Add(v)
+			m.Add(v)
+		}
+	}
+	assert.Equal(t, m.Len(), s.Len())
+	want := m.Values()
+	slices.Sort(want)
+	assert.Equal(t, want, s.Values())
+	assert.Equal(t, []int(want), slices.Collect(s.All()))
+	s.Remove(10000)
+}
+
+func TestBitSet_Operations(t *testing.T) {
+	tests := []struct {
+		name                    string
+		s1, s2                  []int
+		wantUnion               []int
+		wantIntersect           []int
+		wantDifference          []int
+		wantSymmetricDifference []int
+		wantSubset              bool
+		wantEqual               bool
+	}{
+		{
+			name:                    "empty",
+			wantUnion:               []int{},
+			wantIntersect:           []int{},
+			wantDifference:          []int{},
+			wantSymmetricDifference: []int{},
+			wantSubset:              true,
+			wantEqual:               true,
+		},
+		{
+			name:                    "overlap across words",
+			s1:                      []int{1, 64, 200},
+			s2:                      []int{64, 65},
+			wantUnion:               []int{1, 64, 65, 200},
+			wantIntersect:           []int{64},
+			wantDifference:          []int{1, 200},
+			wantSymmetricDifference: []int{1, 65, 200},
+		},
+		{
+			name:                    "subset",
+			s1:                      []int{3},
+			s2:                      []int{3, 300},
+			wantUnion:               []int{3, 300},
+			wantIntersect:           []int{3},
+			wantDifference:          []int{},
+			wantSymmetricDifference: []int{300},
+			wantSubset:              true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s1, s2 := container.NewBitSet(tt.s1...), container.NewBitSet(tt.s2...)
+			assert.Equal(t, tt.wantUnion, []int(s1.Union(s2).Values()))
+			assert.Equal(t, tt.wantIntersect, []int(s1.Intersect(s2).Values()))
+			assert.Equal(t, tt.wantDifference, []int(s1.Difference(s2).Values()))
+			assert.Equal(t, tt.wantSymmetricDifference, []int(s1.SymmetricDifference(s2).Values()))
+			assert.Equal(t, tt.wantSubset, s1.IsSubsetOf(s2))
+			assert.Equal(t, tt.wantEqual, s1.Equal(s2))
+			assert.True(t, s1.Union(s2).Equal(s2.Union(s1)))
+		})
+	}
+}
+
+func TestBitSet_Equal(t *testing.T) {
+	s1 := container.NewBitSet(1, 500)
+	s2 := container.NewBitSet(1)
+	assert.False(t, s1.Equal(s2))
+	assert.False(t, s2.Equal(s1))
+	s1.Remove(500)
+	assert.True(t, s1.Equal(s2))
+	assert.True(t, s2.Equal(s1))
+}
+
+func TestBitSet_Binary(t *testing.T) {
+	s := container.NewBitSet[uint16](0, 63, 64, 1000)
+	s.Add(5000)
+	s.Remove(5000)
+	data, err := s.MarshalBinary()
+	assert.NoError(t, err)
+	assert.Len(t, data, 16*8)
+	got := container.NewBitSet[uint16](7)
+	assert.NoError(t, got.UnmarshalBinary(data))
+	assert.True(t, s.Equal(got))
+	assert.Equal(t, []uint16{0, 63, 64, 1000}, []uint16(got.Values()))
+	assert.ErrorIs(t, got.UnmarshalBinary([]byte{1, 2, 3}), genfuncs.IllegalArguments)
+}
@@ -0,0 +1,217 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"context"
+	"fmt"
+	"github.com/nwillc/genfuncs"
+	"sync"
+	"time"
+)
+
+// BlockingQueue implements Queue.
+var _ Queue[int] = (*BlockingQueue[int])(nil)
+
+// QueueClosed error is returned by blocking operations on a closed BlockingQueue.
+var QueueClosed = fmt.Errorf("queue closed")
+
+// BlockingQueue is a capacity bounded, GoRoutine safe Queue whose Put and Take block until space or an element is
+// available. The ordering of the BlockingQueue is that of the Queue backing it. BlockingQueue implements Queue.
+type BlockingQueue[T any] struct {
+	lock     sync.Mutex
+	queue    Queue[T]
+	capacity int
+	closed   bool
+	changed  chan struct{}
+}
+
+// NewBlockingQueue creates a first in first out BlockingQueue, backed by a Deque, with the given capacity.
+func NewBlockingQueue[T any](capacity int) (queue *BlockingQueue[T]) {
+	queue = newBlockingQueue[T](capacity, NewDeque[T]())
+	return queue
+}
+
+// NewPriorityBlockingQueue creates a BlockingQueue, backed by a Heap ordered by the compare, with the given capacity.
+func NewPriorityBlockingQueue[T any](capacity int, compare genfuncs.BiFunction[T, T, bool]) (queue *BlockingQueue[T]) {
+	queue = newBlockingQueue[T](capacity, NewHeap[T](compare))
+	return queue
+}
+
+// Add an element to the BlockingQueue, blocking until space is available. Add panics with IllegalState if the
+// BlockingQueue is closed.
+func (q *BlockingQueue[T]) Add(t T) {
+	if err := q.Put(context.Background(), t); err != nil {
+		panic(fmt.Errorf("%w: %w", genfuncs.IllegalState, err))
+	}
+}
+
+// AddAll elements to the BlockingQueue, blocking as required for each element.
+func (q *BlockingQueue[T]) AddAll(t ...T) {
+	for _, e := range t {
+		q.Add(e)
+	}
+}
+
+// Capacity returns the maximum number of elements the BlockingQueue holds.
+func (q *BlockingQueue[T]) Capacity() (capacity int) {
+	capacity = q.capacity
+	return capacity
+}
+
+// Close the BlockingQueue. Subsequent Put's fail and Take's fail once the remaining elements are taken. Blocked
+// callers are released. Closing a closed BlockingQueue has no effect.
+func (q *BlockingQueue[T]) Close() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if !q.closed {
+		q.closed = true
+		q.signal()
+	}
+}
+
+// Closed returns true if the BlockingQueue has been closed.
+func (q *BlockingQueue[T]) Closed() (closed bool) {
+	q.lock.Lock()
+	closed = q.closed
+	q.lock.Unlock()
+	return closed
+}
+
+// Len returns the number of elements in the BlockingQueue.
+func (q *BlockingQueue[T]) Len() (length int) {
+	q.lock.Lock()
+	length = q.queue.Len()
+	q.lock.Unlock()
+	return length
+}
+
+// Offer an element to the BlockingQueue, waiting up to timeout for space to be available. Returns true if the
+// element was added.
+func (q *BlockingQueue[T]) Offer(t T, timeout time.Duration) (ok bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	ok = q.Put(ctx, t) == nil
+	return ok
+}
+
+// Peek returns the next element without removing it, panicking with NoSuchElement if the BlockingQueue is empty.
+func (q *BlockingQueue[T]) Peek() (value T) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	value = q.queue.Peek()
+	return value
+}
+
+// Poll removes the next element from the BlockingQueue, waiting up to timeout for one to be available.
+func (q *BlockingQueue[T]) Poll(timeout time.Duration) (result *genfuncs.Result[T]) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	result = q.Take(ctx)
+	return result
+}
+
+// Put an element in the BlockingQueue, blocking until space is available. Returns QueueClosed if the BlockingQueue is
+// closed, or the context's error if it ends first.
+func (q *BlockingQueue[T]) Put(ctx context.Context, t T) (err error) {
+	err = q.await(ctx, func() (bool, error) {
+		if q.closed {
+			return false, QueueClosed
+		}
+		if q.queue.Len() >= q.capacity {
+			return false, nil
+		}
+		q.queue.Add(t)
+		return true, nil
+	})
+	return err
+}
+
+// Remove the next element, panicking with NoSuchElement if the BlockingQueue is empty.
+func (q *BlockingQueue[T]) Remove() (value T) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	value = q.queue.Remove()
+	q.signal()
+	return value
+}
+
+// Take the next element from the BlockingQueue, blocking until one is available. The Result is an error of
+// QueueClosed if the BlockingQueue is closed and empty, or the context's error if it ends first.
+func (q *BlockingQueue[T]) Take(ctx context.Context) (result *genfuncs.Result[T]) {
+	var value T
+	err := q.await(ctx, func() (bool, error) {
+		if q.queue.Len() > 0 {
+			value = q.queue.Remove()
+			return true, nil
+		}
+		if q.closed {
+			return false, QueueClosed
+		}
+		return false, nil
+	})
+	result = genfuncs.NewResultError(value, err)
+	return result
+}
+
+// Values returns a copy of the elements in the BlockingQueue in the order of the backing Queue's Values.
+func (q *BlockingQueue[T]) Values() (values GSlice[T]) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	values = append(GSlice[T]{}, q.queue.Values()...)
+	return values
+}
+
+func newBlockingQueue[T any](capacity int, queue Queue[T]) (blockingQueue *BlockingQueue[T]) {
+	if capacity < 1 {
+		panic(fmt.Errorf("%w: queue capacity %d is less than one", genfuncs.IllegalArguments, capacity))
+	}
+	blockingQueue = &BlockingQueue[T]{
+		queue:    queue,
+		capacity: capacity,
+		changed:  make(chan struct{}),
+	}
+	return blockingQueue
+}
+
+// await repeatedly attempts the operation, under lock, until it is done, fails or the context ends, waiting for the
+// BlockingQueue to change between attempts. The operation is attempted before the context is checked.
+func (q *BlockingQueue[T]) await(ctx context.Context, operation func() (bool, error)) error {
+	for {
+		q.lock.Lock()
+		done, err := operation()
+		if done {
+			q.signal()
+		}
+		changed := q.changed
+		q.lock.Unlock()
+		if done || err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// signal wakes all waiters by closing the current changed channel and replacing it. Must be called under lock.
+func (q *BlockingQueue[T]) signal() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
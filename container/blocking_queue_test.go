@@ -0,0 +1,172 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"context"
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewBlockingQueue(t *testing.T) {
+	q := container.NewBlockingQueue[int](2)
+	assert.Equal(t, 0, q.Len())
+	assert.Equal(t, 2, q.Capacity())
+	assert.False(t, q.Closed())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = q.Peek() })
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = q.Remove() })
+	assert.PanicsWithError(t, genfuncs.IllegalArguments.Error()+": queue capacity 0 is less than one", func() {
+		_ = container.NewBlockingQueue[int](0)
+	})
+}
+
+func TestBlockingQueue_Queue(t *testing.T) {
+	tests := []struct {
+		name  string
+		queue *container.BlockingQueue[int]
+		want  []int
+	}{
+		{
+			name:  "FIFO",
+			queue: container.NewBlockingQueue[int](5),
+			want:  []int{3, 1, 2},
+		},
+		{
+			name:  "Priority",
+			queue: container.NewPriorityBlockingQueue[int](5, genfuncs.OrderedLess[int]),
+			want:  []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.queue.AddAll(3, 1, 2)
+			assert.Equal(t, 3, tt.queue.Len())
+			assert.ElementsMatch(t, tt.want, tt.queue.Values())
+			assert.Equal(t, tt.want[0], tt.queue.Peek())
+			var got []int
+			for tt.queue.Len() > 0 {
+				got = append(got, tt.queue.Remove())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBlockingQueue_OfferPoll(t *testing.T) {
+	q := container.NewBlockingQueue[int](1)
+	assert.True(t, q.Offer(1, 0))
+	assert.False(t, q.Offer(2, time.Millisecond))
+	result := q.Poll(0)
+	assert.True(t, result.Ok())
+	assert.Equal(t, 1, result.OrEmpty())
+	result = q.Poll(time.Millisecond)
+	assert.ErrorIs(t, result.Error(), context.DeadlineExceeded)
+}
+
+func TestBlockingQueue_Blocking(t *testing.T) {
+	q := container.NewBlockingQueue[int](1)
+	ctx := context.Background()
+	assert.NoError(t, q.Put(ctx, 1))
+	put := make(chan error)
+	go func() { put <- q.Put(ctx, 2) }()
+	select {
+	case <-put:
+		assert.Fail(t, "put should block while full")
+	case <-time.After(10 * time.Millisecond):
+	}
+	assert.Equal(t, 1, q.Take(ctx).OrEmpty())
+	assert.NoError(t, <-put)
+
+	assert.Equal(t, 2, q.Take(ctx).OrEmpty())
+	taken := make(chan *genfuncs.Result[int])
+	go func() { taken <- q.Take(ctx) }()
+	q.Add(3)
+	assert.Equal(t, 3, (<-taken).OrEmpty())
+}
+
+func TestBlockingQueue_Context(t *testing.T) {
+	q := container.NewBlockingQueue[int](1)
+	q.Add(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	put := make(chan error)
+	go func() { put <- q.Put(ctx, 2) }()
+	cancel()
+	assert.ErrorIs(t, <-put, context.Canceled)
+	assert.Equal(t, 1, q.Take(ctx).OrEmpty())
+	assert.ErrorIs(t, q.Take(ctx).Error(), context.Canceled)
+}
+
+func TestBlockingQueue_Close(t *testing.T) {
+	q := container.NewBlockingQueue[int](2)
+	ctx := context.Background()
+	q.Add(1)
+	taken := make(chan *genfuncs.Result[int])
+	go func() {
+		_ = q.Take(ctx)
+		taken <- q.Take(ctx)
+	}()
+	q.Close()
+	q.Close()
+	assert.True(t, q.Closed())
+	assert.ErrorIs(t, (<-taken).Error(), container.QueueClosed)
+	assert.ErrorIs(t, q.Put(ctx, 2), container.QueueClosed)
+	assert.False(t, q.Offer(2, 0))
+	assert.Panics(t, func() { q.Add(2) })
+}
+
+func TestBlockingQueue_ProducersConsumers(t *testing.T) {
+	q := container.NewPriorityBlockingQueue[int](3, genfuncs.OrderedLess[int])
+	ctx := context.Background()
+	var producers sync.WaitGroup
+	for p := 0; p < 4; p++ {
+		producers.Add(1)
+		go func(p int) {
+			defer producers.Done()
+			for i := 0; i < 25; i++ {
+				assert.NoError(t, q.Put(ctx, p*25+i))
+			}
+		}(p)
+	}
+	results := make(chan int, 100)
+	var consumers sync.WaitGroup
+	for c := 0; c < 3; c++ {
+		consumers.Add(1)
+		go func() {
+			defer consumers.Done()
+			for {
+				result := q.Take(ctx)
+				if !result.Ok() {
+					return
+				}
+				results <- result.OrEmpty()
+			}
+		}()
+	}
+	producers.Wait()
+	q.Close()
+	consumers.Wait()
+	close(results)
+	seen := make(map[int]bool)
+	for v := range results {
+		seen[v] = true
+	}
+	assert.Len(t, seen, 100)
+}
@@ -0,0 +1,328 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"fmt"
+	"github.com/nwillc/genfuncs"
+	"iter"
+	"sync"
+	"time"
+)
+
+// Cache implements Map.
+var _ Map[int, int] = (*Cache[int, int])(nil)
+
+const (
+	// LRU evicts the least recently used entry.
+	LRU EvictionPolicy = iota
+	// LFU evicts the least frequently used entry, and of those the least recently used.
+	LFU
+)
+
+type (
+	// EvictionPolicy selects the entry a Cache evicts when full.
+	EvictionPolicy int
+	// EvictionListener is notified of entries evicted from a Cache due to capacity or expiry.
+	EvictionListener[K comparable, V any] func(key K, value V)
+	// CacheConfig holds the configuration of a Cache.
+	CacheConfig struct {
+		// Capacity is the maximum number of entries in the Cache, and must be positive.
+		Capacity int
+		// Policy is the EvictionPolicy used when the Cache is full.
+		Policy EvictionPolicy
+		// TTL is the default time to live of entries, zero meaning entries do not expire.
+		TTL time.Duration
+		// Clock returns the current time, defaulting to time.Now.
+		Clock func() time.Time
+		// ThreadSafe makes the Cache GoRoutine safe.
+		ThreadSafe bool
+	}
+	// CacheStats are the statistics of a Cache.
+	CacheStats struct {
+		Hits        int
+		Misses      int
+		Evictions   int
+		Expirations int
+	}
+	// Cache is a capacity bounded Map that evicts entries based on an EvictionPolicy, and optionally expires entries
+	// after a time to live. Cache is built from a GMap index, List's of its entries, and an IndexedHeap of the entries
+	// that expire. Cache implements Map.
+	Cache[K comparable, V any] struct {
+		config    CacheConfig
+		index     GMap[K, *cacheEntry[K, V]]
+		policy    cachePolicy[K, V]
+		expiring  *IndexedHeap[K, *cacheEntry[K, V]]
+		listeners []EvictionListener[K, V]
+		stats     CacheStats
+		lock      *sync.Mutex
+	}
+	cacheEntry[K comparable, V any] struct {
+		key       K
+		value     V
+		expires   time.Time
+		frequency int
+		element   *ListElement[*cacheEntry[K, V]]
+	}
+)
+
+// NewCache creates a new Cache with the given configuration.
+func NewCache[K comparable, V any](config CacheConfig) (cache *Cache[K, V]) {
+	if config.Capacity < 1 {
+		panic(fmt.Errorf("%w: cache capacity %d is less than one", genfuncs.IllegalArguments, config.Capacity))
+	}
+	if config.Clock == nil {
+		config.Clock = time.Now
+	}
+	cache = &Cache[K, V]{
+		config: config,
+		index:  make(GMap[K, *cacheEntry[K, V]], config.Capacity),
+		policy: newCachePolicy[K, V](config.Policy),
+		expiring: NewIndexedHeap[K, *cacheEntry[K, V]](
+			func(a, b *cacheEntry[K, V]) bool { return a.expires.Before(b.expires) },
+			func(e *cacheEntry[K, V]) K { return e.key },
+		),
+	}
+	if config.ThreadSafe {
+		cache.lock = new(sync.Mutex)
+	}
+	return cache
+}
+
+// AddEvictionListener adds an EvictionListener to the Cache. Listeners are notified after the Cache operation
+// causing the eviction completes.
+func (c *Cache[K, V]) AddEvictionListener(listener EvictionListener[K, V]) {
+	c.acquire()
+	defer c.release()
+	c.listeners = append(c.listeners, listener)
+}
+
+// All returns an iter.Seq2 of a snapshot of the keys and values of the unexpired entries in the Cache.
+func (c *Cache[K, V]) All() (seq iter.Seq2[K, V]) {
+	entries := c.entries()
+	seq = func(yield func(K, V) bool) {
+		for _, e := range entries {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
+// Contains returns true if the Cache contains an unexpired entry for the key. Contains is not a use of the entry.
+func (c *Cache[K, V]) Contains(key K) (contains bool) {
+	c.acquire()
+	e, ok := c.index[key]
+	contains = ok && !c.expired(e, c.config.Clock())
+	c.release()
+	return contains
+}
+
+// Delete an entry from the Cache. Deleted entries are not evictions.
+func (c *Cache[K, V]) Delete(key K) {
+	c.acquire()
+	defer c.release()
+	if e, ok := c.index[key]; ok {
+		c.remove(e)
+	}
+}
+
+// ForEach traverses a snapshot of the unexpired entries in the Cache applying the given function.
+func (c *Cache[K, V]) ForEach(f func(key K, value V)) {
+	for _, e := range c.entries() {
+		f(e.Key, e.Value)
+	}
+}
+
+// Get the value for the key, which is a use of the entry. The returned ok value will be false if the key is not
+// contained in the Cache or has expired.
+func (c *Cache[K, V]) Get(key K) (value V, ok bool) {
+	c.acquire()
+	var evicted []*cacheEntry[K, V]
+	var e *cacheEntry[K, V]
+	e, ok = c.index[key]
+	if ok && c.expired(e, c.config.Clock()) {
+		c.remove(e)
+		c.stats.Expirations++
+		evicted = append(evicted, e)
+		ok = false
+	}
+	if ok {
+		c.stats.Hits++
+		c.policy.accessed(e)
+		value = e.value
+	} else {
+		c.stats.Misses++
+	}
+	c.release()
+	c.notify(evicted)
+	return value, ok
+}
+
+// Iterator returns an Iterator over a snapshot of the values of the unexpired entries in the Cache.
+func (c *Cache[K, V]) Iterator() Iterator[V] {
+	return c.Values().Iterator()
+}
+
+// Keys returns the keys of the unexpired entries in the Cache.
+func (c *Cache[K, V]) Keys() (keys GSlice[K]) {
+	entries := c.entries()
+	keys = make(GSlice[K], len(entries))
+	for i := range entries {
+		keys[i] = entries[i].Key
+	}
+	return keys
+}
+
+// Len returns the number of unexpired entries in the Cache, first evicting any expired entries.
+func (c *Cache[K, V]) Len() (length int) {
+	c.acquire()
+	evicted := c.purge(c.config.Clock())
+	length = len(c.index)
+	c.release()
+	c.notify(evicted)
+	return length
+}
+
+// Put a key value pair into the Cache with the configured time to live. Put is a use of the entry.
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.config.TTL)
+}
+
+// PutWithTTL puts a key value pair into the Cache with the given time to live, zero meaning the entry does not
+// expire. If the Cache is full expired entries are evicted and then if required an entry selected by the
+// EvictionPolicy. PutWithTTL is a use of the entry.
+func (c *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	c.acquire()
+	var evicted []*cacheEntry[K, V]
+	now := c.config.Clock()
+	var expires time.Time
+	if ttl > 0 {
+		expires = now.Add(ttl)
+	}
+	if e, ok := c.index[key]; ok {
+		e.value = value
+		e.expires = expires
+		c.expire(e)
+		c.policy.accessed(e)
+		c.release()
+		return
+	}
+	if len(c.index) >= c.config.Capacity {
+		evicted = c.purge(now)
+	}
+	if len(c.index) >= c.config.Capacity {
+		victim := c.policy.victim()
+		c.remove(victim)
+		c.stats.Evictions++
+		evicted = append(evicted, victim)
+	}
+	e := &cacheEntry[K, V]{key: key, value: value, expires: expires}
+	c.index[key] = e
+	c.expire(e)
+	c.policy.added(e)
+	c.release()
+	c.notify(evicted)
+}
+
+// Stats returns a copy of the statistics of the Cache.
+func (c *Cache[K, V]) Stats() (stats CacheStats) {
+	c.acquire()
+	stats = c.stats
+	c.release()
+	return stats
+}
+
+// Values returns the values of the unexpired entries in the Cache.
+func (c *Cache[K, V]) Values() (values GSlice[V]) {
+	entries := c.entries()
+	values = make(GSlice[V], len(entries))
+	for i := range entries {
+		values[i] = entries[i].Value
+	}
+	return values
+}
+
+func (c *Cache[K, V]) acquire() {
+	if c.lock != nil {
+		c.lock.Lock()
+	}
+}
+
+func (c *Cache[K, V]) release() {
+	if c.lock != nil {
+		c.lock.Unlock()
+	}
+}
+
+// entries purges expired entries and returns a snapshot of those remaining.
+func (c *Cache[K, V]) entries() (entries GSlice[Entry[K, V]]) {
+	c.acquire()
+	evicted := c.purge(c.config.Clock())
+	entries = make(GSlice[Entry[K, V]], 0, len(c.index))
+	for k, e := range c.index {
+		entries = append(entries, Entry[K, V]{Key: k, Value: e.value})
+	}
+	c.release()
+	c.notify(evicted)
+	return entries
+}
+
+// expire tracks the entry in the expiring IndexedHeap if it has an expiry time.
+func (c *Cache[K, V]) expire(e *cacheEntry[K, V]) {
+	if e.expires.IsZero() {
+		c.expiring.RemoveKey(e.key)
+		return
+	}
+	c.expiring.Add(e)
+}
+
+func (c *Cache[K, V]) expired(e *cacheEntry[K, V], now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
+
+func (c *Cache[K, V]) notify(evicted []*cacheEntry[K, V]) {
+	if len(evicted) == 0 {
+		return
+	}
+	c.acquire()
+	listeners := c.listeners
+	c.release()
+	for _, e := range evicted {
+		for _, listener := range listeners {
+			listener(e.key, e.value)
+		}
+	}
+}
+
+// purge removes the expired entries, soonest expiring first.
+func (c *Cache[K, V]) purge(now time.Time) (evicted []*cacheEntry[K, V]) {
+	for c.expiring.Len() > 0 && c.expired(c.expiring.Peek(), now) {
+		e := c.expiring.Peek()
+		c.remove(e)
+		c.stats.Expirations++
+		evicted = append(evicted, e)
+	}
+	return evicted
+}
+
+func (c *Cache[K, V]) remove(e *cacheEntry[K, V]) {
+	delete(c.index, e.key)
+	c.expiring.RemoveKey(e.key)
+	c.policy.removed(e)
+}
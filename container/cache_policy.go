@@ -0,0 +1,110 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+var (
+	_ cachePolicy[int, int] = (*lruPolicy[int, int])(nil)
+	_ cachePolicy[int, int] = (*lfuPolicy[int, int])(nil)
+)
+
+type (
+	// cachePolicy tracks the entries of a Cache to select the victim when the Cache is full.
+	cachePolicy[K comparable, V any] interface {
+		accessed(entry *cacheEntry[K, V])
+		added(entry *cacheEntry[K, V])
+		removed(entry *cacheEntry[K, V])
+		victim() *cacheEntry[K, V]
+	}
+	// lruPolicy keeps the entries in a List with the least recently used at the left.
+	lruPolicy[K comparable, V any] struct {
+		list *List[*cacheEntry[K, V]]
+	}
+	// lfuPolicy keeps the entries in a List per use frequency, each with the least recently used at the left, and the
+	// lowest frequency in use.
+	lfuPolicy[K comparable, V any] struct {
+		buckets GMap[int, *List[*cacheEntry[K, V]]]
+		min     int
+	}
+)
+
+func newCachePolicy[K comparable, V any](policy EvictionPolicy) cachePolicy[K, V] {
+	if policy == LFU {
+		return &lfuPolicy[K, V]{buckets: make(GMap[int, *List[*cacheEntry[K, V]]])}
+	}
+	return &lruPolicy[K, V]{list: NewList[*cacheEntry[K, V]]()}
+}
+
+func (l *lruPolicy[K, V]) accessed(entry *cacheEntry[K, V]) {
+	l.list.MoveToBack(entry.element)
+}
+
+func (l *lruPolicy[K, V]) added(entry *cacheEntry[K, V]) {
+	entry.element = l.list.AddRight(entry)
+}
+
+func (l *lruPolicy[K, V]) removed(entry *cacheEntry[K, V]) {
+	l.list.Remove(entry.element)
+}
+
+func (l *lruPolicy[K, V]) victim() *cacheEntry[K, V] {
+	return l.list.PeekLeft().Value
+}
+
+func (l *lfuPolicy[K, V]) accessed(entry *cacheEntry[K, V]) {
+	l.removed(entry)
+	if entry.frequency == l.min && !l.buckets.Contains(l.min) {
+		l.min++
+	}
+	entry.frequency++
+	l.add(entry)
+}
+
+func (l *lfuPolicy[K, V]) added(entry *cacheEntry[K, V]) {
+	entry.frequency = 1
+	l.min = 1
+	l.add(entry)
+}
+
+func (l *lfuPolicy[K, V]) removed(entry *cacheEntry[K, V]) {
+	bucket := l.buckets[entry.frequency]
+	bucket.Remove(entry.element)
+	if bucket.Len() == 0 {
+		delete(l.buckets, entry.frequency)
+	}
+}
+
+func (l *lfuPolicy[K, V]) victim() *cacheEntry[K, V] {
+	if !l.buckets.Contains(l.min) {
+		first := true
+		for frequency := range l.buckets {
+			if first || frequency < l.min {
+				l.min = frequency
+				first = false
+			}
+		}
+	}
+	return l.buckets[l.min].PeekLeft().Value
+}
+
+func (l *lfuPolicy[K, V]) add(entry *cacheEntry[K, V]) {
+	bucket, ok := l.buckets[entry.frequency]
+	if !ok {
+		bucket = NewList[*cacheEntry[K, V]]()
+		l.buckets[entry.frequency] = bucket
+	}
+	entry.element = bucket.AddRight(entry)
+}
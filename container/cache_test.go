@@ -0,0 +1,211 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c *testClock) Now() time.Time { return c.now }
+
+func (c *testClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func TestNewCache(t *testing.T) {
+	c := container.NewCache[string, int](container.CacheConfig{Capacity: 2})
+	assert.Equal(t, 0, c.Len())
+	assert.False(t, c.Iterator().HasNext())
+	_, ok := c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, container.CacheStats{Misses: 1}, c.Stats())
+	assert.PanicsWithError(t, genfuncs.IllegalArguments.Error()+": cache capacity 0 is less than one", func() {
+		_ = container.NewCache[string, int](container.CacheConfig{})
+	})
+}
+
+func TestCache_Evict(t *testing.T) {
+	tests := []struct {
+		name    string
+		policy  container.EvictionPolicy
+		gets    []string
+		wantOut []string
+	}{
+		{
+			name:    "LRU no gets",
+			policy:  container.LRU,
+			wantOut: []string{"a"},
+		},
+		{
+			name:    "LRU get oldest",
+			policy:  container.LRU,
+			gets:    []string{"a"},
+			wantOut: []string{"b"},
+		},
+		{
+			name:    "LFU no gets",
+			policy:  container.LFU,
+			wantOut: []string{"a"},
+		},
+		{
+			name:    "LFU frequency before recency",
+			policy:  container.LFU,
+			gets:    []string{"a", "a", "b", "c", "b"},
+			wantOut: []string{"c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := container.NewCache[string, int](container.CacheConfig{Capacity: 3, Policy: tt.policy})
+			var evicted []string
+			c.AddEvictionListener(func(key string, _ int) { evicted = append(evicted, key) })
+			c.Put("a", 1)
+			c.Put("b", 2)
+			c.Put("c", 3)
+			for _, k := range tt.gets {
+				_, ok := c.Get(k)
+				assert.True(t, ok)
+			}
+			c.Put("d", 4)
+			assert.Equal(t, tt.wantOut, evicted)
+			assert.Equal(t, 3, c.Len())
+			assert.False(t, c.Contains(tt.wantOut[0]))
+			assert.True(t, c.Contains("d"))
+			assert.Equal(t, 1, c.Stats().Evictions)
+		})
+	}
+}
+
+func TestCache_LFUDelete(t *testing.T) {
+	c := container.NewCache[string, int](container.CacheConfig{Capacity: 2, Policy: container.LFU})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("b")
+	c.Delete("a")
+	c.Put("c", 3)
+	c.Get("c")
+	c.Get("c")
+	c.Put("d", 4)
+	assert.False(t, c.Contains("b"))
+	assert.True(t, c.Contains("c"))
+	assert.True(t, c.Contains("d"))
+}
+
+func TestCache_TTL(t *testing.T) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	c := container.NewCache[string, int](container.CacheConfig{Capacity: 2, TTL: time.Minute, Clock: clock.Now})
+	var evicted []string
+	c.AddEvictionListener(func(key string, _ int) { evicted = append(evicted, key) })
+	c.Put("a", 1)
+	c.PutWithTTL("b", 2, 0)
+	clock.Advance(30 * time.Second)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	clock.Advance(30 * time.Second)
+	assert.False(t, c.Contains("a"))
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"a"}, evicted)
+	assert.Equal(t, container.CacheStats{Hits: 1, Misses: 1, Expirations: 1}, c.Stats())
+	clock.Advance(time.Hour)
+	assert.True(t, c.Contains("b"))
+
+	c.PutWithTTL("c", 3, time.Second)
+	c.Put("d", 4)
+	assert.Equal(t, []string{"a", "b"}, evicted)
+	clock.Advance(time.Second)
+	assert.Equal(t, []string{"d"}, []string(c.Keys()))
+	assert.Equal(t, []string{"a", "b", "c"}, evicted)
+	assert.Equal(t, 2, c.Stats().Expirations)
+}
+
+func TestCache_LenExpired(t *testing.T) {
+	clock := &testClock{now: time.Unix(0, 0)}
+	c := container.NewCache[string, int](container.CacheConfig{Capacity: 3, Clock: clock.Now})
+	var evicted []string
+	c.AddEvictionListener(func(key string, _ int) { evicted = append(evicted, key) })
+	c.PutWithTTL("a", 1, 2*time.Second)
+	c.PutWithTTL("b", 2, time.Second)
+	c.Put("c", 3)
+	assert.Equal(t, 3, c.Len())
+	clock.Advance(time.Second)
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, c.Keys().Len(), c.Len())
+	assert.Equal(t, []string{"b"}, evicted)
+
+	c.PutWithTTL("a", 1, 0)
+	clock.Advance(time.Hour)
+	c.Put("d", 4)
+	assert.Equal(t, 3, c.Len())
+	assert.Equal(t, c.Keys().Len(), c.Len())
+	assert.ElementsMatch(t, []string{"a", "c", "d"}, []string(c.Keys()))
+	assert.Equal(t, 1, c.Stats().Expirations)
+}
+
+func TestCache_Map(t *testing.T) {
+	c := container.NewCache[string, int](container.CacheConfig{Capacity: 3})
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("a", 3)
+	assert.Equal(t, 2, c.Len())
+	keys := c.Keys()
+	sort.Strings(keys)
+	assert.Equal(t, []string{"a", "b"}, []string(keys))
+	values := c.Values()
+	sort.Ints(values)
+	assert.Equal(t, []int{2, 3}, []int(values))
+	sum := 0
+	c.ForEach(func(_ string, v int) { sum += v })
+	assert.Equal(t, 5, sum)
+	for k, v := range c.All() {
+		got, _ := c.Get(k)
+		assert.Equal(t, got, v)
+	}
+	c.Delete("a")
+	c.Delete("z")
+	assert.False(t, c.Contains("a"))
+	assert.Equal(t, 1, c.Len())
+}
+
+func TestCache_ThreadSafe(t *testing.T) {
+	c := container.NewCache[int, int](container.CacheConfig{Capacity: 10, Policy: container.LFU, ThreadSafe: true})
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				c.Put(w*100+i, i)
+				c.Get(i)
+			}
+		}(w)
+	}
+	wg.Wait()
+	assert.Equal(t, 10, c.Len())
+	stats := c.Stats()
+	assert.Equal(t, 390, stats.Evictions)
+	assert.Equal(t, 400, stats.Hits+stats.Misses)
+}
@@ -16,7 +16,10 @@
 
 package container
 
-import "github.com/nwillc/genfuncs"
+import (
+	"github.com/nwillc/genfuncs"
+	"iter"
+)
 
 var _ Queue[int] = (*Deque[int])(nil)
 
@@ -53,6 +56,12 @@ func (d *Deque[T]) AddRight(t T) {
 	d.list.AddRight(t)
 }
 
+// All returns an iter.Seq of the elements in the Deque from left to right.
+func (d *Deque[T]) All() (seq iter.Seq[T]) {
+	seq = d.list.All()
+	return seq
+}
+
 func (d *Deque[T]) Iterator() Iterator[T] {
 	return d.list.Iterator()
 }
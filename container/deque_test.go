@@ -209,3 +209,13 @@ func TestDeque_Iterator(t *testing.T) {
 	s := sequences.NewSequence(1, 2, 3)
 	assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](m, s, genfuncs.Ordered[int]))
 }
+
+func TestDeque_All(t *testing.T) {
+	d := container.NewDeque(1, 2, 3)
+	d.AddLeft(0)
+	var got container.GSlice[int]
+	for v := range d.All() {
+		got = append(got, v)
+	}
+	assert.Equal(t, container.GSlice[int]{0, 1, 2, 3}, got)
+}
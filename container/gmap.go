@@ -19,6 +19,7 @@ package container
 import (
 	"github.com/nwillc/genfuncs"
 	"golang.org/x/exp/maps"
+	"iter"
 )
 
 // GMap implements the Map interface.
@@ -37,15 +38,16 @@ type (
 	}
 )
 
-// All returns true if all values in GMap satisfy the predicate.
-func (m GMap[K, V]) All(predicate genfuncs.Function[V, bool]) (ok bool) {
-	for _, v := range m {
-		if !predicate(v) {
-			return ok
+// All returns an iter.Seq2 of the keys and values of the GMap.
+func (m GMap[K, V]) All() (seq iter.Seq2[K, V]) {
+	seq = func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
 		}
 	}
-	ok = true
-	return ok
+	return seq
 }
 
 // Any returns true if any values in GMap satisfy the predicate.
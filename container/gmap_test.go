@@ -200,6 +200,21 @@ func TestGMap_FilterKeys(t *testing.T) {
 }
 
 func TestGMap_All(t *testing.T) {
+	m := container.GMap[string, int]{"a": 1, "b": 2, "c": 3}
+	got := make(container.GMap[string, int])
+	for k, v := range m.All() {
+		got[k] = v
+	}
+	assert.Equal(t, m, got)
+	count := 0
+	for range m.All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
+
+func TestGMap_AllMatch(t *testing.T) {
 	m := container.GMap[string, string]{"a": "a", "b": "b", "c": "c"}
 	type args struct {
 		predicate genfuncs.Function[string, bool]
@@ -245,7 +260,7 @@ func TestGMap_All(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			assert.Equal(t, tt.want, tt.args.m.All(tt.args.predicate))
+			assert.Equal(t, tt.want, sequences.All[string](tt.args.m, tt.args.predicate))
 		})
 	}
 }
@@ -19,6 +19,7 @@ package container
 import (
 	"github.com/nwillc/genfuncs"
 	"golang.org/x/exp/slices"
+	"iter"
 	"math/rand"
 	"time"
 )
@@ -39,6 +40,19 @@ type (
 	}
 )
 
+// All returns an iter.Seq2 of the indexes and elements of the GSlice.
+func (s GSlice[T]) All() (seq iter.Seq2[int, T]) {
+	seq = func(yield func(int, T) bool) {
+		length := len(s)
+		for i := 0; i < length; i++ {
+			if !yield(i, s[i]) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
 // Filter returns a slice containing only elements matching the given predicate.
 func (s GSlice[T]) Filter(predicate genfuncs.Function[T, bool]) GSlice[T] {
 	length := len(s)
@@ -404,3 +404,16 @@ func Test_sliceIterator_NoHasNext(t *testing.T) {
 		_ = iterator.Next()
 	})
 }
+
+func TestGSlice_All(t *testing.T) {
+	s := container.GSlice[string]{"a", "b", "c"}
+	var got container.GSlice[string]
+	for i, v := range s.All() {
+		assert.Equal(t, s[i], v)
+		got = append(got, v)
+		if i == 1 {
+			break
+		}
+	}
+	assert.Equal(t, container.GSlice[string]{"a", "b"}, got)
+}
@@ -55,6 +55,17 @@ func GroupBy[T any, K comparable](slice container.GSlice[T], keyFor maps.KeyFor[
 	return result
 }
 
+// HeapSort returns a new container.GSlice of the elements of the slice sorted by the order function, leaving the
+// slice unchanged. The sort is not stable.
+func HeapSort[T any](slice container.GSlice[T], order genfuncs.BiFunction[T, T, bool]) (sorted container.GSlice[T]) {
+	heap := container.NewHeap(order, slice...)
+	sorted = make(container.GSlice[T], 0, len(slice))
+	for iterator := heap.Drain().Iterator(); iterator.HasNext(); {
+		sorted = append(sorted, iterator.Next())
+	}
+	return sorted
+}
+
 // Map returns a new container.GSlice containing the results of applying the given transform function to each element in the original slice.
 func Map[T, R any](slice container.GSlice[T], transform genfuncs.Function[T, R]) container.GSlice[R] {
 	length := len(slice)
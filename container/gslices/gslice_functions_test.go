@@ -138,6 +138,42 @@ func TestGroupBy(t *testing.T) {
 	}
 }
 
+func TestHeapSort(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice container.GSlice[int]
+		order genfuncs.BiFunction[int, int, bool]
+		want  []int
+	}{
+		{
+			name:  "Empty",
+			slice: []int{},
+			order: genfuncs.OrderedLess[int],
+			want:  []int{},
+		},
+		{
+			name:  "Ascending",
+			slice: []int{3, 1, 4, 1, 5, 9, 2, 6},
+			order: genfuncs.OrderedLess[int],
+			want:  []int{1, 1, 2, 3, 4, 5, 6, 9},
+		},
+		{
+			name:  "Descending",
+			slice: []int{3, 1, 4, 1, 5, 9, 2, 6},
+			order: genfuncs.OrderedGreater[int],
+			want:  []int{9, 6, 5, 4, 3, 2, 1, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := append(container.GSlice[int]{}, tt.slice...)
+			got := gslices.HeapSort(tt.slice, tt.order)
+			assert.Equal(t, tt.want, []int(got))
+			assert.Equal(t, original, tt.slice)
+		})
+	}
+}
+
 func TestMap(t *testing.T) {
 	var trans = strconv.Itoa
 	type args struct {
@@ -17,19 +17,42 @@
 package container
 
 import (
+	"fmt"
 	"github.com/nwillc/genfuncs"
+	"iter"
 )
 
-// Heap implements Queue.
-var _ Queue[int] = (*Heap[int])(nil)
+var (
+	// Heap implements Queue and Sequence.
+	_ Queue[int]    = (*Heap[int])(nil)
+	_ Sequence[int] = (*Heap[int])(nil)
+	_ Iterator[int] = (*heapIterator[int])(nil)
+	_ Sequence[int] = (*heapDrain[int])(nil)
+	_ Iterator[int] = (*heapDrainIterator[int])(nil)
+)
 
-// Heap implements an ordered heap of any type which can be min heap or max heap depending on the compare provided.
-// Heap implements Queue.
-type Heap[T any] struct {
-	slice   GSlice[T]
-	compare genfuncs.BiFunction[T, T, bool]
-	ordered bool
-}
+type (
+	// Heap implements an ordered heap of any type which can be min heap or max heap depending on the compare provided.
+	// Heap implements Queue and Sequence, iterating in priority order without removing elements.
+	Heap[T any] struct {
+		slice    GSlice[T]
+		compare  genfuncs.BiFunction[T, T, bool]
+		modCount int
+	}
+	// heapIterator traverses a Heap in priority order using a heap of the indexes of the frontier of elements not yet
+	// returned.
+	heapIterator[T any] struct {
+		heap     *Heap[T]
+		frontier *Heap[int]
+		modCount int
+	}
+	heapDrain[T any] struct {
+		heap *Heap[T]
+	}
+	heapDrainIterator[T any] struct {
+		heap *Heap[T]
+	}
+)
 
 // NewHeap return a heap ordered based on the compare and adds any values provided.
 func NewHeap[T any](compare genfuncs.BiFunction[T, T, bool], values ...T) (heap *Heap[T]) {
@@ -45,16 +68,46 @@ func NewHeap[T any](compare genfuncs.BiFunction[T, T, bool], values ...T) (heap
 func (h *Heap[T]) Add(v T) {
 	h.slice = append(h.slice, v)
 	h.up(h.Len() - 1)
-	h.ordered = false
+	h.modCount++
 }
 
 // AddAll the values onto the Heap.
 func (h *Heap[T]) AddAll(values ...T) {
-	end := h.Len()
-	h.slice = append(h.slice, values...)
-	for ; end < h.Len(); end++ {
-		h.up(end)
+	for _, v := range values {
+		h.Add(v)
+	}
+}
+
+// All returns an iter.Seq of the values of the Heap in priority order, without removing them.
+func (h *Heap[T]) All() (seq iter.Seq[T]) {
+	seq = func(yield func(T) bool) {
+		for iterator := h.Iterator(); iterator.HasNext(); {
+			if !yield(iterator.Next()) {
+				return
+			}
+		}
 	}
+	return seq
+}
+
+// Drain returns a Sequence that removes the values of the Heap in priority order as it is iterated.
+func (h *Heap[T]) Drain() (sequence Sequence[T]) {
+	sequence = &heapDrain[T]{heap: h}
+	return sequence
+}
+
+// Iterator returns an Iterator over the values of the Heap in priority order, without removing them. The Iterator
+// panics with IllegalState if the Heap is modified during iteration.
+func (h *Heap[T]) Iterator() Iterator[T] {
+	iterator := &heapIterator[T]{
+		heap:     h,
+		frontier: NewHeap[int](func(i, j int) bool { return h.compare(h.slice[i], h.slice[j]) }),
+		modCount: h.modCount,
+	}
+	if h.Len() > 0 {
+		iterator.frontier.Add(0)
+	}
+	return iterator
 }
 
 // Len returns current length of the heap.
@@ -65,57 +118,69 @@ func (h *Heap[T]) Peek() (value T) {
 	if h.Len() <= 0 {
 		panic(genfuncs.NoSuchElement)
 	}
-	n := h.Len() - 1
-	if n > 0 && !h.ordered {
-		h.slice.Swap(0, n)
-		h.down(0)
-		h.ordered = true
-	}
-	value = h.slice[n]
+	value = h.slice[0]
 	return value
 }
 
 // Remove an item off the heap.
 func (h *Heap[T]) Remove() (value T) {
 	value = h.Peek()
-	h.slice = h.slice[0 : h.Len()-1]
-	h.ordered = false
+	n := h.Len() - 1
+	h.slice.Swap(0, n)
+	var zero T
+	h.slice[n] = zero
+	h.slice = h.slice[:n]
+	h.down(0)
+	h.modCount++
 	return value
 }
 
-// Values returns a slice of the values in the Heap in no particular order.
+// Values returns a copy of the values in the Heap in no particular order.
 func (h *Heap[T]) Values() (values GSlice[T]) {
-	values = h.slice
+	values = append(GSlice[T]{}, h.slice...)
 	return values
 }
 
 func (h *Heap[T]) up(i int) {
-	for {
+	siftUp(h.slice, i, h.compare, h.slice.Swap)
+}
+
+func (h *Heap[T]) down(i int) {
+	siftDown(h.slice, i, h.compare, h.slice.Swap)
+}
+
+// siftUp moves the element at i toward the root while it precedes its parent, using swap to exchange elements, and
+// returns true if it moved.
+func siftUp[T any](slice GSlice[T], i int, compare genfuncs.BiFunction[T, T, bool], swap func(i, j int)) (moved bool) {
+	for i > 0 {
 		iParent := parent(i)
-		if i < 1 || iParent == i || !h.compare(h.slice[i], h.slice[iParent]) {
+		if !compare(slice[i], slice[iParent]) {
 			break
 		}
-		h.slice.Swap(iParent, i)
+		swap(iParent, i)
 		i = iParent
+		moved = true
 	}
+	return moved
 }
 
-func (h *Heap[T]) down(i int) {
-	length := h.Len() - 1
+// siftDown moves the element at i toward the leaves while a child precedes it, using swap to exchange elements.
+func siftDown[T any](slice GSlice[T], i int, compare genfuncs.BiFunction[T, T, bool], swap func(i, j int)) {
+	length := slice.Len()
 	for {
 		l := left(i)
-		if l < 0 || l >= length {
+		if l >= length {
 			break
 		}
 		j := l
 		r := right(i)
-		if r < length && h.compare(h.slice[r], h.slice[l]) {
+		if r < length && compare(slice[r], slice[l]) {
 			j = r
 		}
-		if !h.compare(h.slice[j], h.slice[i]) {
+		if !compare(slice[j], slice[i]) {
 			break
 		}
-		h.slice.Swap(i, j)
+		swap(i, j)
 		i = j
 	}
 }
@@ -123,3 +188,41 @@ func (h *Heap[T]) down(i int) {
 func parent(i int) (p int) { p = (i - 1) / 2; return p }
 func left(i int) (l int)   { l = (i * 2) + 1; return l }
 func right(i int) (r int)  { r = left(i) + 1; return r }
+
+func (i *heapIterator[T]) HasNext() bool {
+	i.checkModification()
+	return i.frontier.Len() > 0
+}
+
+func (i *heapIterator[T]) Next() (value T) {
+	if !i.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	index := i.frontier.Remove()
+	for _, child := range []int{left(index), right(index)} {
+		if child < i.heap.Len() {
+			i.frontier.Add(child)
+		}
+	}
+	value = i.heap.slice[index]
+	return value
+}
+
+func (i *heapIterator[T]) checkModification() {
+	if i.modCount != i.heap.modCount {
+		panic(fmt.Errorf("%w: heap modified during iteration", genfuncs.IllegalState))
+	}
+}
+
+func (d *heapDrain[T]) Iterator() Iterator[T] {
+	return &heapDrainIterator[T]{heap: d.heap}
+}
+
+func (i *heapDrainIterator[T]) HasNext() bool {
+	return i.heap.Len() > 0
+}
+
+func (i *heapDrainIterator[T]) Next() (value T) {
+	value = i.heap.Remove()
+	return value
+}
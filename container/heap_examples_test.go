@@ -29,6 +29,7 @@ func TestHeapFunctionExamples(t *testing.T) {
 	tests.MaybeRunExamples(t)
 	// Heap
 	ExampleNewHeap()
+	ExampleNewIndexedHeap()
 }
 
 func ExampleNewHeap() {
@@ -39,3 +40,32 @@ func ExampleNewHeap() {
 	fmt.Println()
 	// Output: 1234
 }
+
+func ExampleNewIndexedHeap() {
+	type distance = genfuncs.Pair[string, int]
+	edges := map[string]map[string]int{
+		"a": {"b": 7, "c": 2},
+		"b": {"d": 1},
+		"c": {"b": 3, "d": 8},
+	}
+	byDistance := func(d1, d2 distance) bool { return d1.Second < d2.Second }
+	byNode := func(d distance) string { return d.First }
+	heap := container.NewIndexedHeap(byDistance, byNode, genfuncs.NewPair("a", 0))
+	settled := map[string]bool{}
+	for heap.Len() > 0 {
+		current := heap.Remove()
+		settled[current.First] = true
+		fmt.Println(current)
+		for node, weight := range edges[current.First] {
+			candidate := current.Second + weight
+			if known, ok := heap.Get(node); !settled[node] && (!ok || candidate < known.Second) {
+				heap.Add(genfuncs.NewPair(node, candidate))
+			}
+		}
+	}
+	// Output:
+	// (a, 0)
+	// (c, 2)
+	// (b, 5)
+	// (d, 6)
+}
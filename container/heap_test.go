@@ -22,6 +22,7 @@ import (
 	"github.com/nwillc/genfuncs/container/sequences"
 	"github.com/stretchr/testify/assert"
 	"math/rand"
+	"slices"
 	"testing"
 	"time"
 )
@@ -197,3 +198,55 @@ func TestHeap_Values(t *testing.T) {
 	h := container.NewHeap[int](genfuncs.OrderedLess[int], s...)
 	assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](s, h.Values(), genfuncs.Ordered[int]))
 }
+
+func TestHeap_ValuesCopy(t *testing.T) {
+	h := container.NewHeap[int](genfuncs.OrderedLess[int], 1, 2, 3)
+	values := h.Values()
+	values[0] = 10
+	assert.Equal(t, 1, h.Peek())
+}
+
+func TestHeap_Iterator(t *testing.T) {
+	random := rand.New(rand.NewSource(time.Now().Unix()))
+	tests := []struct {
+		name  string
+		order genfuncs.BiFunction[int, int, bool]
+		count int
+	}{
+		{name: "empty", order: genfuncs.OrderedLess[int]},
+		{name: "min", order: genfuncs.OrderedLess[int], count: 100},
+		{name: "max", order: genfuncs.OrderedGreater[int], count: 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := container.NewHeap[int](tt.order)
+			for i := 0; i < tt.count; i++ {
+				h.Add(random.Intn(100))
+			}
+			got := slices.Collect(h.All())
+			assert.Len(t, got, tt.count)
+			assert.True(t, sequences.IsSorted[int](container.GSlice[int](got), tt.order))
+			assert.Equal(t, tt.count, h.Len())
+			var drained []int
+			for iterator := h.Drain().Iterator(); iterator.HasNext(); {
+				drained = append(drained, iterator.Next())
+			}
+			assert.Equal(t, got, drained)
+			assert.Equal(t, 0, h.Len())
+			assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = h.Iterator().Next() })
+		})
+	}
+}
+
+func TestHeap_IteratorModified(t *testing.T) {
+	h := container.NewHeap[int](genfuncs.OrderedLess[int], 3, 1, 2)
+	iterator := h.Iterator()
+	assert.Equal(t, 1, iterator.Next())
+	h.Add(0)
+	assert.PanicsWithError(t, genfuncs.IllegalState.Error()+": heap modified during iteration", func() { _ = iterator.HasNext() })
+	assert.PanicsWithError(t, genfuncs.IllegalState.Error()+": heap modified during iteration", func() { _ = iterator.Next() })
+	iterator = h.Iterator()
+	_ = h.Remove()
+	assert.Panics(t, func() { _ = iterator.Next() })
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(h.All()))
+}
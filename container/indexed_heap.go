@@ -0,0 +1,174 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"fmt"
+	"github.com/nwillc/genfuncs"
+)
+
+// IndexedHeap implements Queue.
+var _ Queue[int] = (*IndexedHeap[string, int])(nil)
+
+// IndexedHeap is a heap ordered by the compare provided, with each element identified by a key from the keyFor
+// function. The positions of elements are tracked by key, allowing them to be updated or removed. IndexedHeap
+// implements Queue.
+type IndexedHeap[K comparable, T any] struct {
+	slice     GSlice[T]
+	positions GMap[K, int]
+	compare   genfuncs.BiFunction[T, T, bool]
+	keyFor    genfuncs.Function[T, K]
+}
+
+// NewIndexedHeap returns an IndexedHeap ordered based on the compare and keyed by keyFor, and adds any values
+// provided.
+func NewIndexedHeap[K comparable, T any](
+	compare genfuncs.BiFunction[T, T, bool],
+	keyFor genfuncs.Function[T, K],
+	values ...T,
+) (heap *IndexedHeap[K, T]) {
+	heap = &IndexedHeap[K, T]{
+		slice:     make(GSlice[T], 0, len(values)),
+		positions: make(GMap[K, int], len(values)),
+		compare:   compare,
+		keyFor:    keyFor,
+	}
+	heap.AddAll(values...)
+	return heap
+}
+
+// Add a value to the IndexedHeap, replacing any value with the same key.
+func (h *IndexedHeap[K, T]) Add(value T) {
+	key := h.keyFor(value)
+	if h.Update(key, value) {
+		return
+	}
+	h.slice = append(h.slice, value)
+	h.positions[key] = h.Len() - 1
+	h.up(h.Len() - 1)
+}
+
+// AddAll the values to the IndexedHeap.
+func (h *IndexedHeap[K, T]) AddAll(values ...T) {
+	for _, v := range values {
+		h.Add(v)
+	}
+}
+
+// Contains returns true if the IndexedHeap contains a value with the key.
+func (h *IndexedHeap[K, T]) Contains(key K) (contains bool) {
+	contains = h.positions.Contains(key)
+	return contains
+}
+
+// Get the value with the key. The returned ok value will be false if the key is not contained in the IndexedHeap.
+func (h *IndexedHeap[K, T]) Get(key K) (value T, ok bool) {
+	var i int
+	if i, ok = h.positions[key]; ok {
+		value = h.slice[i]
+	}
+	return value, ok
+}
+
+// Len returns current length of the IndexedHeap.
+func (h *IndexedHeap[K, T]) Len() (length int) {
+	length = h.slice.Len()
+	return length
+}
+
+// Peek returns the next element without removing it.
+func (h *IndexedHeap[K, T]) Peek() (value T) {
+	if h.Len() == 0 {
+		panic(genfuncs.NoSuchElement)
+	}
+	value = h.slice[0]
+	return value
+}
+
+// Remove the next element from the IndexedHeap.
+func (h *IndexedHeap[K, T]) Remove() (value T) {
+	value = h.Peek()
+	h.removeAt(0)
+	return value
+}
+
+// RemoveKey removes the value with the key from the IndexedHeap. The returned ok value will be false if the key is
+// not contained in the IndexedHeap.
+func (h *IndexedHeap[K, T]) RemoveKey(key K) (value T, ok bool) {
+	var i int
+	if i, ok = h.positions[key]; ok {
+		value = h.slice[i]
+		h.removeAt(i)
+	}
+	return value, ok
+}
+
+// Update replaces the value with the key and restores the order of the IndexedHeap. The value's key must be the key
+// given, otherwise Update panics with genfuncs.IllegalArguments. The returned ok value will be false if the key is not
+// contained in the IndexedHeap.
+func (h *IndexedHeap[K, T]) Update(key K, value T) (ok bool) {
+	if h.keyFor(value) != key {
+		panic(fmt.Errorf("%w: indexed heap value key %v is not %v", genfuncs.IllegalArguments, h.keyFor(value), key))
+	}
+	var i int
+	if i, ok = h.positions[key]; ok {
+		h.slice[i] = value
+		h.fix(i)
+	}
+	return ok
+}
+
+// Values returns a copy of the values in the IndexedHeap in no particular order.
+func (h *IndexedHeap[K, T]) Values() (values GSlice[T]) {
+	values = append(GSlice[T]{}, h.slice...)
+	return values
+}
+
+func (h *IndexedHeap[K, T]) fix(i int) {
+	if !h.up(i) {
+		h.down(i)
+	}
+}
+
+func (h *IndexedHeap[K, T]) removeAt(i int) {
+	n := h.Len() - 1
+	if i != n {
+		h.swap(i, n)
+	}
+	delete(h.positions, h.keyFor(h.slice[n]))
+	var zero T
+	h.slice[n] = zero
+	h.slice = h.slice[:n]
+	if i != n {
+		h.fix(i)
+	}
+}
+
+func (h *IndexedHeap[K, T]) swap(i, j int) {
+	h.slice.Swap(i, j)
+	h.positions[h.keyFor(h.slice[i])] = i
+	h.positions[h.keyFor(h.slice[j])] = j
+}
+
+func (h *IndexedHeap[K, T]) up(i int) (moved bool) {
+	moved = siftUp(h.slice, i, h.compare, h.swap)
+	return moved
+}
+
+func (h *IndexedHeap[K, T]) down(i int) {
+	siftDown(h.slice, i, h.compare, h.swap)
+}
@@ -0,0 +1,148 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+type task = genfuncs.Pair[string, int]
+
+func newTaskHeap(tasks ...task) *container.IndexedHeap[string, task] {
+	return container.NewIndexedHeap(
+		func(t1, t2 task) bool { return t1.Second < t2.Second },
+		func(t task) string { return t.First },
+		tasks...,
+	)
+}
+
+func drainTasks(heap *container.IndexedHeap[string, task]) (names string) {
+	for heap.Len() > 0 {
+		names += heap.Remove().First
+	}
+	return names
+}
+
+func TestNewIndexedHeap(t *testing.T) {
+	heap := newTaskHeap()
+	assert.Equal(t, 0, heap.Len())
+	assert.False(t, heap.Contains("a"))
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = heap.Peek() })
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = heap.Remove() })
+	_, ok := heap.RemoveKey("a")
+	assert.False(t, ok)
+	assert.False(t, heap.Update("a", genfuncs.NewPair("a", 1)))
+	heap.Add(genfuncs.NewPair("a", 1))
+	assert.PanicsWithError(t, genfuncs.IllegalArguments.Error()+": indexed heap value key b is not a", func() {
+		heap.Update("a", genfuncs.NewPair("b", 2))
+	})
+	assert.False(t, heap.Contains("b"))
+	assert.Equal(t, 1, heap.Len())
+}
+
+func TestIndexedHeap(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(heap *container.IndexedHeap[string, task])
+		want   string
+	}{
+		{
+			name:   "none",
+			change: func(heap *container.IndexedHeap[string, task]) {},
+			want:   "abcde",
+		},
+		{
+			name:   "decrease",
+			change: func(heap *container.IndexedHeap[string, task]) { heap.Update("d", genfuncs.NewPair("d", 0)) },
+			want:   "dabce",
+		},
+		{
+			name:   "increase",
+			change: func(heap *container.IndexedHeap[string, task]) { heap.Update("a", genfuncs.NewPair("a", 9)) },
+			want:   "bcdea",
+		},
+		{
+			name:   "add replaces",
+			change: func(heap *container.IndexedHeap[string, task]) { heap.Add(genfuncs.NewPair("c", 9)) },
+			want:   "abdec",
+		},
+		{
+			name: "remove key",
+			change: func(heap *container.IndexedHeap[string, task]) {
+				removed, ok := heap.RemoveKey("b")
+				assert.True(t, ok)
+				assert.Equal(t, 2, removed.Second)
+				_, ok = heap.RemoveKey("e")
+				assert.True(t, ok)
+			},
+			want: "acd",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			heap := newTaskHeap(
+				genfuncs.NewPair("e", 5), genfuncs.NewPair("c", 3), genfuncs.NewPair("a", 1),
+				genfuncs.NewPair("d", 4), genfuncs.NewPair("b", 2),
+			)
+			tt.change(heap)
+			assert.Equal(t, len(tt.want), heap.Len())
+			assert.Len(t, heap.Values(), len(tt.want))
+			for _, k := range tt.want {
+				assert.True(t, heap.Contains(string(k)))
+			}
+			assert.Equal(t, tt.want[:1], heap.Peek().First)
+			assert.Equal(t, tt.want, drainTasks(heap))
+		})
+	}
+}
+
+func TestIndexedHeap_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(time.Now().Unix()))
+	heap := container.NewIndexedHeap(genfuncs.OrderedLess[int], func(i int) int { return i % 100 })
+	want := map[int]int{}
+	for i := 0; i < 2000; i++ {
+		v := random.Intn(10000)
+		switch random.Intn(3) {
+		case 0:
+			key := v % 100
+			_, ok := heap.RemoveKey(key)
+			_, wantOk := want[key]
+			assert.Equal(t, wantOk, ok)
+			delete(want, key)
+		default:
+			heap.Add(v)
+			want[v%100] = v
+		}
+	}
+	for k, v := range want {
+		got, ok := heap.Get(k)
+		assert.True(t, ok)
+		assert.Equal(t, v, got)
+	}
+	var got []int
+	for heap.Len() > 0 {
+		got = append(got, heap.Remove())
+	}
+	assert.Len(t, got, len(want))
+	assert.True(t, slices.IsSorted(got))
+}
@@ -16,27 +16,42 @@
 
 package container
 
+import (
+	"fmt"
+	"github.com/nwillc/genfuncs"
+)
+
 var (
 	_ Sequence[int] = (*iteratorSequence[int])(nil)
 )
 
 type (
+	// Iterator provides a means to traverse the elements of a Sequence.
 	Iterator[T any] interface {
 		HasNext() bool
 		Next() T
 	}
+	// Sequence provides an Iterator over its elements. A Sequence that returns a new Iterator each time one is
+	// requested is re-iterable.
 	Sequence[T any] interface {
 		Iterator() Iterator[T]
 	}
 	iteratorSequence[T any] struct {
 		iterator Iterator[T]
+		iterated bool
 	}
 )
 
+// NewIteratorSequence creates a single use Sequence from an Iterator. Requesting a second Iterator from the Sequence
+// panics with genfuncs.IllegalState.
 func NewIteratorSequence[T any](iterator Iterator[T]) Sequence[T] {
 	return &iteratorSequence[T]{iterator: iterator}
 }
 
-func (i iteratorSequence[T]) Iterator() Iterator[T] {
+func (i *iteratorSequence[T]) Iterator() Iterator[T] {
+	if i.iterated {
+		panic(fmt.Errorf("%w: sequence can only be iterated once", genfuncs.IllegalState))
+	}
+	i.iterated = true
 	return i.iterator
 }
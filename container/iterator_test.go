@@ -29,3 +29,11 @@ func TestNewIteratorSequence(t *testing.T) {
 	seq2 := container.NewIteratorSequence(seq1.Iterator())
 	assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](seq1, seq2, genfuncs.Ordered[int]))
 }
+
+func TestNewIteratorSequence_Once(t *testing.T) {
+	seq := container.NewIteratorSequence(sequences.NewSequence(1, 2, 3).Iterator())
+	_ = seq.Iterator()
+	assert.PanicsWithError(t, "illegal state: sequence can only be iterated once", func() {
+		_ = seq.Iterator()
+	})
+}
@@ -0,0 +1,203 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"github.com/nwillc/genfuncs"
+	"iter"
+)
+
+var (
+	// LinkedMap implements Map.
+	_ Map[int, int]              = (*LinkedMap[int, int])(nil)
+	_ Sequence[*Entry[int, int]] = (*linkedMapEntries[int, int])(nil)
+	_ Iterator[int]              = (*linkedMapIterator[int, int, int])(nil)
+)
+
+type (
+	// LinkedMap is a Map implementation that combines a GMap index with a List of its entries, providing iteration in
+	// insertion order or, optionally, access order. LinkedMap implements Map.
+	LinkedMap[K comparable, V any] struct {
+		index       GMap[K, *ListElement[*Entry[K, V]]]
+		list        *List[*Entry[K, V]]
+		accessOrder bool
+	}
+	linkedMapEntries[K comparable, V any] struct {
+		linkedMap *LinkedMap[K, V]
+	}
+	linkedMapIterator[K comparable, V, R any] struct {
+		element *ListElement[*Entry[K, V]]
+		get     func(entry *Entry[K, V]) R
+	}
+)
+
+// NewLinkedMap creates a new LinkedMap that iterates in the order keys were first put.
+func NewLinkedMap[K comparable, V any]() (linkedMap *LinkedMap[K, V]) {
+	linkedMap = &LinkedMap[K, V]{index: make(GMap[K, *ListElement[*Entry[K, V]]]), list: NewList[*Entry[K, V]]()}
+	return linkedMap
+}
+
+// NewAccessOrderLinkedMap creates a new LinkedMap that iterates in access order, from least recently to most recently
+// accessed. Both Get and Put are accesses.
+func NewAccessOrderLinkedMap[K comparable, V any]() (linkedMap *LinkedMap[K, V]) {
+	linkedMap = NewLinkedMap[K, V]()
+	linkedMap.accessOrder = true
+	return linkedMap
+}
+
+// All returns an iter.Seq2 of the keys and values of the LinkedMap in order.
+func (l *LinkedMap[K, V]) All() (seq iter.Seq2[K, V]) {
+	seq = func(yield func(K, V) bool) {
+		for e := l.list.PeekLeft(); e != nil; e = e.Next() {
+			if !yield(e.Value.Key, e.Value.Value) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
+// Contains returns true if the LinkedMap contains the given key. Contains is not an access.
+func (l *LinkedMap[K, V]) Contains(key K) (contains bool) {
+	contains = l.index.Contains(key)
+	return contains
+}
+
+// Delete an entry from the LinkedMap.
+func (l *LinkedMap[K, V]) Delete(key K) {
+	e, ok := l.index[key]
+	if !ok {
+		return
+	}
+	delete(l.index, key)
+	l.list.Remove(e)
+}
+
+// Entries returns a Sequence of copies of the entries in the LinkedMap in order.
+func (l *LinkedMap[K, V]) Entries() (sequence Sequence[*Entry[K, V]]) {
+	sequence = &linkedMapEntries[K, V]{linkedMap: l}
+	return sequence
+}
+
+// ForEach traverses the LinkedMap in order applying the given function to all entries.
+func (l *LinkedMap[K, V]) ForEach(f func(key K, value V)) {
+	for e := l.list.PeekLeft(); e != nil; e = e.Next() {
+		f(e.Value.Key, e.Value.Value)
+	}
+}
+
+// Get the value for the key. The returned ok value will be false if the key is not contained in the LinkedMap.
+func (l *LinkedMap[K, V]) Get(key K) (value V, ok bool) {
+	var e *ListElement[*Entry[K, V]]
+	e, ok = l.index[key]
+	if !ok {
+		return value, ok
+	}
+	if l.accessOrder {
+		l.list.MoveToBack(e)
+	}
+	value = e.Value.Value
+	return value, ok
+}
+
+// Iterator returns an Iterator over the values of the LinkedMap in order.
+func (l *LinkedMap[K, V]) Iterator() Iterator[V] {
+	return &linkedMapIterator[K, V, V]{element: l.list.PeekLeft(), get: entryValue[K, V]}
+}
+
+// Keys returns the keys of the LinkedMap in order.
+func (l *LinkedMap[K, V]) Keys() (keys GSlice[K]) {
+	keys = make(GSlice[K], 0, l.Len())
+	for e := l.list.PeekLeft(); e != nil; e = e.Next() {
+		keys = append(keys, e.Value.Key)
+	}
+	return keys
+}
+
+// Len returns the number of entries in the LinkedMap.
+func (l *LinkedMap[K, V]) Len() (length int) {
+	length = l.list.Len()
+	return length
+}
+
+// MoveToBack moves the entry for the key to the back, the end of the iteration order. The returned ok value will be
+// false if the key is not contained in the LinkedMap.
+func (l *LinkedMap[K, V]) MoveToBack(key K) (ok bool) {
+	var e *ListElement[*Entry[K, V]]
+	if e, ok = l.index[key]; ok {
+		l.list.MoveToBack(e)
+	}
+	return ok
+}
+
+// MoveToFront moves the entry for the key to the front, the start of the iteration order. The returned ok value will
+// be false if the key is not contained in the LinkedMap.
+func (l *LinkedMap[K, V]) MoveToFront(key K) (ok bool) {
+	var e *ListElement[*Entry[K, V]]
+	if e, ok = l.index[key]; ok {
+		l.list.MoveToFront(e)
+	}
+	return ok
+}
+
+// Put a key value pair into the LinkedMap. A new key is added at the back. Replacing the value of an existing key
+// does not change the order unless the LinkedMap is in access order.
+func (l *LinkedMap[K, V]) Put(key K, value V) {
+	e, ok := l.index[key]
+	if !ok {
+		l.index[key] = l.list.AddRight(NewEntry(key, value))
+		return
+	}
+	e.Value.Value = value
+	if l.accessOrder {
+		l.list.MoveToBack(e)
+	}
+}
+
+// Values returns the values of the LinkedMap in order.
+func (l *LinkedMap[K, V]) Values() (values GSlice[V]) {
+	values = make(GSlice[V], 0, l.Len())
+	for e := l.list.PeekLeft(); e != nil; e = e.Next() {
+		values = append(values, e.Value.Value)
+	}
+	return values
+}
+
+func entryValue[K comparable, V any](entry *Entry[K, V]) V {
+	return entry.Value
+}
+
+func entryCopy[K comparable, V any](entry *Entry[K, V]) *Entry[K, V] {
+	return NewEntry(entry.Key, entry.Value)
+}
+
+func (l *linkedMapEntries[K, V]) Iterator() Iterator[*Entry[K, V]] {
+	return &linkedMapIterator[K, V, *Entry[K, V]]{element: l.linkedMap.list.PeekLeft(), get: entryCopy[K, V]}
+}
+
+func (l *linkedMapIterator[K, V, R]) HasNext() bool {
+	return l.element != nil
+}
+
+func (l *linkedMapIterator[K, V, R]) Next() (value R) {
+	if !l.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	value = l.get(l.element.Value)
+	l.element = l.element.Next()
+	return value
+}
@@ -0,0 +1,112 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/nwillc/genfuncs/container/sequences"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestNewLinkedMap(t *testing.T) {
+	m := container.NewLinkedMap[string, int]()
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.Iterator().HasNext())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = m.Iterator().Next() })
+}
+
+func TestLinkedMap_InsertionOrder(t *testing.T) {
+	m := container.NewLinkedMap[string, int]()
+	for i := 9; i >= 0; i-- {
+		m.Put(strconv.Itoa(i), i)
+	}
+	m.Put("5", 50)
+	_, _ = m.Get("9")
+	assert.Equal(t, container.GSlice[string]{"9", "8", "7", "6", "5", "4", "3", "2", "1", "0"}, m.Keys())
+	assert.Equal(t, container.GSlice[int]{9, 8, 7, 6, 50, 4, 3, 2, 1, 0}, m.Values())
+	assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](m, m.Values(), genfuncs.Ordered[int]))
+	assert.Equal(t, "9,8,7,6,50,4,3,2,1,0", sequences.JoinToString[int](m, strconv.Itoa, ",", "", ""))
+}
+
+func TestLinkedMap_AccessOrder(t *testing.T) {
+	m := container.NewAccessOrderLinkedMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	v, ok := m.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	assert.Equal(t, container.GSlice[string]{"b", "c", "a"}, m.Keys())
+	m.Put("b", 20)
+	assert.Equal(t, container.GSlice[string]{"c", "a", "b"}, m.Keys())
+	assert.True(t, m.Contains("c"))
+	assert.Equal(t, container.GSlice[string]{"c", "a", "b"}, m.Keys())
+	_, ok = m.Get("z")
+	assert.False(t, ok)
+}
+
+func TestLinkedMap_Delete(t *testing.T) {
+	m := container.NewLinkedMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Delete("a")
+	m.Delete("z")
+	assert.Equal(t, 1, m.Len())
+	assert.False(t, m.Contains("a"))
+	assert.Equal(t, container.GSlice[string]{"b"}, m.Keys())
+	m.Put("a", 3)
+	assert.Equal(t, container.GSlice[string]{"b", "a"}, m.Keys())
+}
+
+func TestLinkedMap_Move(t *testing.T) {
+	m := container.NewLinkedMap[string, int]()
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	assert.True(t, m.MoveToFront("c"))
+	assert.Equal(t, container.GSlice[string]{"c", "a", "b"}, m.Keys())
+	assert.True(t, m.MoveToBack("c"))
+	assert.True(t, m.MoveToBack("a"))
+	assert.Equal(t, container.GSlice[string]{"b", "c", "a"}, m.Keys())
+	assert.False(t, m.MoveToFront("z"))
+	assert.False(t, m.MoveToBack("z"))
+	v, ok := m.Get("c")
+	assert.True(t, ok)
+	assert.Equal(t, 3, v)
+}
+
+func TestLinkedMap_Entries(t *testing.T) {
+	m := container.NewLinkedMap[string, int]()
+	m.Put("b", 2)
+	m.Put("a", 1)
+	key := func(e *container.Entry[string, int]) string { return e.Key }
+	assert.Equal(t, genfuncs.EqualTo, sequences.Compare[string](sequences.Map(m.Entries(), key), m.Keys(), genfuncs.Ordered[string]))
+	sequences.ForEach(m.Entries(), func(e *container.Entry[string, int]) { e.Value = 0 })
+	assert.Equal(t, container.GSlice[int]{2, 1}, m.Values())
+	var keys container.GSlice[string]
+	m.ForEach(func(k string, _ int) { keys = append(keys, k) })
+	assert.Equal(t, container.GSlice[string]{"b", "a"}, keys)
+	keys = nil
+	for k := range m.All() {
+		keys = append(keys, k)
+		break
+	}
+	assert.Equal(t, container.GSlice[string]{"b"}, keys)
+}
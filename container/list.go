@@ -18,6 +18,7 @@ package container
 
 import (
 	"github.com/nwillc/genfuncs"
+	"iter"
 )
 
 var (
@@ -31,13 +32,21 @@ type (
 	// ListElement is an element of List.
 	ListElement[T any] struct {
 		next, prev *ListElement[T]
-		list       *List[T]
+		owner      *listOwner[T]
 		Value      T
 	}
 	// List is a doubly linked list, inspired by list.List but reworked to be generic. List implements Container.
 	List[T any] struct {
-		root ListElement[T]
-		len  int
+		root  ListElement[T]
+		len   int
+		owner *listOwner[T]
+	}
+	// listOwner records the List owning ListElements. When a List's elements are spliced into another List, its
+	// listOwner is forwarded to the other's, allowing the move in constant time. When a List is cleared its listOwner
+	// is orphaned.
+	listOwner[T any] struct {
+		list    *List[T]
+		forward *listOwner[T]
 	}
 	listIterator[T any] struct {
 		ListElement *ListElement[T]
@@ -46,7 +55,8 @@ type (
 
 // Next returns the next list element or nil.
 func (e *ListElement[T]) Next() (next *ListElement[T]) {
-	if e.list == nil || e.next == &e.list.root {
+	list := e.list()
+	if list == nil || e.next == &list.root {
 		return next
 	}
 	next = e.next
@@ -55,7 +65,8 @@ func (e *ListElement[T]) Next() (next *ListElement[T]) {
 
 // Prev returns the previous list element or nil.
 func (e *ListElement[T]) Prev() (prev *ListElement[T]) {
-	if e.list == nil || e.prev == &e.list.root {
+	list := e.list()
+	if list == nil || e.prev == &list.root {
 		return prev
 	}
 	prev = e.prev
@@ -73,9 +84,7 @@ func (e *ListElement[T]) Swap(e2 *ListElement[T]) {
 // NewList instantiates a new List containing any values provided.
 func NewList[T any](values ...T) (l *List[T]) {
 	l = new(List[T])
-	l.root.next = &l.root
-	l.root.prev = &l.root
-	l.len = 0
+	l.init()
 	l.AddAll(values...)
 	return l
 }
@@ -107,6 +116,24 @@ func (l *List[T]) AddRight(v T) (e *ListElement[T]) {
 	return e
 }
 
+// All returns an iter.Seq of the values in the List from left to right.
+func (l *List[T]) All() (seq iter.Seq[T]) {
+	seq = func(yield func(T) bool) {
+		for e := l.PeekLeft(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
+// Clear removes all the values from the List in constant time.
+func (l *List[T]) Clear() {
+	l.owner.list = nil
+	l.init()
+}
+
 // ForEach invokes the action for each value in the list.
 func (l *List[T]) ForEach(action func(value T)) {
 	for e := l.PeekLeft(); e != nil; e = e.Next() {
@@ -114,6 +141,26 @@ func (l *List[T]) ForEach(action func(value T)) {
 	}
 }
 
+// InsertAfter inserts a value immediately after the mark and returns its ListElement. If the mark is not an element
+// of the List, the List is not modified and nil is returned.
+func (l *List[T]) InsertAfter(value T, mark *ListElement[T]) (e *ListElement[T]) {
+	if !mark.ownedBy(l) {
+		return e
+	}
+	e = l.insertValue(value, mark)
+	return e
+}
+
+// InsertBefore inserts a value immediately before the mark and returns its ListElement. If the mark is not an
+// element of the List, the List is not modified and nil is returned.
+func (l *List[T]) InsertBefore(value T, mark *ListElement[T]) (e *ListElement[T]) {
+	if !mark.ownedBy(l) {
+		return e
+	}
+	e = l.insertValue(value, mark.prev)
+	return e
+}
+
 // Iterator creates an Iterator for the List.
 func (l *List[T]) Iterator() Iterator[T] {
 	return NewListIterator[T](l)
@@ -125,6 +172,42 @@ func (l *List[T]) Len() (length int) {
 	return length
 }
 
+// MoveAfter moves the element to immediately after the mark. If either is not an element of the List, or they are
+// the same, the List is not modified.
+func (l *List[T]) MoveAfter(e, mark *ListElement[T]) {
+	if e == mark || !e.ownedBy(l) || !mark.ownedBy(l) {
+		return
+	}
+	l.move(e, mark)
+}
+
+// MoveBefore moves the element to immediately before the mark. If either is not an element of the List, or they are
+// the same, the List is not modified.
+func (l *List[T]) MoveBefore(e, mark *ListElement[T]) {
+	if e == mark || !e.ownedBy(l) || !mark.ownedBy(l) {
+		return
+	}
+	l.move(e, mark.prev)
+}
+
+// MoveToBack moves the element to the right of the List. If it is not an element of the List, the List is not
+// modified.
+func (l *List[T]) MoveToBack(e *ListElement[T]) {
+	if !e.ownedBy(l) || l.root.prev == e {
+		return
+	}
+	l.move(e, l.root.prev)
+}
+
+// MoveToFront moves the element to the left of the List. If it is not an element of the List, the List is not
+// modified.
+func (l *List[T]) MoveToFront(e *ListElement[T]) {
+	if !e.ownedBy(l) || l.root.next == e {
+		return
+	}
+	l.move(e, &l.root)
+}
+
 // PeekLeft returns the leftmost value in the List or nil if empty.
 func (l *List[T]) PeekLeft() (e *ListElement[T]) {
 	if l.len != 0 {
@@ -141,21 +224,68 @@ func (l *List[T]) PeekRight() (e *ListElement[T]) {
 	return e
 }
 
+// PushBackList adds copies of the values of the other List to the right of the List, leaving the other unchanged.
+func (l *List[T]) PushBackList(other *List[T]) {
+	for i, e := other.Len(), other.PeekLeft(); i > 0; i, e = i-1, e.next {
+		l.AddRight(e.Value)
+	}
+}
+
 // Remove removes a given value from the List.
 func (l *List[T]) Remove(e *ListElement[T]) (t T) {
-	if e.list == l {
+	if e.ownedBy(l) {
 		l.remove(e)
 	}
 	t = e.Value
 	return t
 }
 
+// Reverse the order of the List's elements.
+func (l *List[T]) Reverse() {
+	e := &l.root
+	for {
+		e.next, e.prev = e.prev, e.next
+		e = e.prev
+		if e == &l.root {
+			return
+		}
+	}
+}
+
 // SortBy sorts the List by the order of the order function. This is not a pure function, the List is sorted, the
-// List returned is to allow for fluid call chains. List does not provide efficient indexed access so a Bubble sort is employed.
+// List returned is to allow for fluid call chains. A stable merge sort relinks the ListElements, so they remain valid
+// and hold the same values.
 func (l *List[T]) SortBy(order genfuncs.BiFunction[T, T, bool]) (result *List[T]) {
 	result = l
-	l.bubbleSort(order)
-	return l
+	l.mergeSort(order)
+	return result
+}
+
+// SortedInsert adds a value to a List sorted by the order function, after any equal values, keeping it sorted.
+func (l *List[T]) SortedInsert(value T, order genfuncs.BiFunction[T, T, bool]) (e *ListElement[T]) {
+	at := l.root.prev
+	for at != &l.root && order(value, at.Value) {
+		at = at.prev
+	}
+	e = l.insertValue(value, at)
+	return e
+}
+
+// Splice moves the elements of the other List to the right of the List in constant time, leaving the other empty.
+// The moved ListElements remain valid as elements of the List.
+func (l *List[T]) Splice(other *List[T]) {
+	if other == l || other.len == 0 {
+		return
+	}
+	first, last := other.root.next, other.root.prev
+	first.prev = l.root.prev
+	l.root.prev.next = first
+	last.next = &l.root
+	l.root.prev = last
+	l.len += other.len
+	other.owner.list = nil
+	other.owner.forward = l.owner
+	other.init()
 }
 
 // Values returns the values in the list as a GSlice.
@@ -169,6 +299,13 @@ func (l *List[T]) Values() (values GSlice[T]) {
 	return values
 }
 
+func (l *List[T]) init() {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.len = 0
+	l.owner = &listOwner[T]{list: l}
+}
+
 func (l *List[T]) insertValue(v T, at *ListElement[T]) (le *ListElement[T]) {
 	le = l.insert(&ListElement[T]{Value: v}, at)
 	return le
@@ -179,7 +316,7 @@ func (l *List[T]) insert(e, at *ListElement[T]) (le *ListElement[T]) {
 	e.next = at.next
 	e.prev.next = e
 	e.next.prev = e
-	e.list = l
+	e.owner = l.owner
 	l.len++
 	le = e
 	return le
@@ -190,28 +327,99 @@ func (l *List[T]) remove(e *ListElement[T]) {
 	e.next.prev = e.prev
 	e.next = nil
 	e.prev = nil
-	e.list = nil
+	e.owner = nil
 	l.len--
 }
 
-// bubbleSort implements a Bubble Sort on List. Because List employs next/prev pointers arbitrary indexing in costly and
-// bubble sort is the fastest sort.
-func (l *List[T]) bubbleSort(order genfuncs.BiFunction[T, T, bool]) {
-	var e1, e2 *ListElement[T]
-	end := l.Len()
-	for end > 1 {
-		e1 = l.PeekLeft()
-		newEnd := 0
-		for i := 1; i < end; i++ {
-			e2 = e1.Next()
-			if order(e2.Value, e1.Value) {
-				e2.Swap(e1)
-				newEnd = i
+// move unlinks the element and links it after at. If the element is at, or already follows it, nothing changes.
+func (l *List[T]) move(e, at *ListElement[T]) {
+	if at == e || at.next == e {
+		return
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+// list returns the List the element belongs to, or nil, following forwarded listOwners. It does not modify the
+// element, so that concurrent readers may traverse a List.
+func (e *ListElement[T]) list() *List[T] {
+	if e.owner == nil {
+		return nil
+	}
+	current := e.owner
+	for current.forward != nil {
+		current = current.forward
+	}
+	return current.list
+}
+
+// ownedBy returns true if the element belongs to the List, compressing the path of forwarded listOwners to the
+// List's. It is only used by mutators of the List.
+func (e *ListElement[T]) ownedBy(l *List[T]) (owned bool) {
+	if owned = e.list() == l; !owned {
+		return owned
+	}
+	for o := e.owner; o != l.owner; {
+		next := o.forward
+		o.forward = l.owner
+		o = next
+	}
+	e.owner = l.owner
+	return owned
+}
+
+// mergeSort implements a bottom up merge sort on List, merging runs of doubling size by relinking the next pointers,
+// then restoring the prev pointers.
+func (l *List[T]) mergeSort(order genfuncs.BiFunction[T, T, bool]) {
+	if l.len < 2 {
+		return
+	}
+	head := l.root.next
+	l.root.prev.next = nil
+	for size := 1; size < l.len; size *= 2 {
+		var tail *ListElement[T]
+		p := head
+		head = nil
+		for p != nil {
+			q := p
+			pSize := 0
+			for pSize < size && q != nil {
+				q = q.next
+				pSize++
 			}
-			e1 = e2
+			qSize := size
+			for pSize > 0 || (qSize > 0 && q != nil) {
+				var e *ListElement[T]
+				if pSize == 0 || (qSize > 0 && q != nil && order(q.Value, p.Value)) {
+					e, q = q, q.next
+					qSize--
+				} else {
+					e, p = p, p.next
+					pSize--
+				}
+				if tail == nil {
+					head = e
+				} else {
+					tail.next = e
+				}
+				tail = e
+			}
+			p = q
 		}
-		end = newEnd
+		tail.next = nil
+	}
+	prev := &l.root
+	for e := head; e != nil; e = e.next {
+		prev.next = e
+		e.prev = prev
+		prev = e
 	}
+	prev.next = &l.root
+	l.root.prev = prev
 }
 
 func NewListIterator[T any](list *List[T]) Iterator[T] {
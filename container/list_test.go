@@ -22,6 +22,8 @@ import (
 	"github.com/nwillc/genfuncs/container/sequences"
 	"github.com/stretchr/testify/assert"
 	"math/rand"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 )
@@ -213,6 +215,12 @@ func TestList_RandomSorts(t *testing.T) {
 				count: 4096,
 			},
 		},
+		{
+			name: "Huge",
+			args: args{
+				count: 50000,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -235,6 +243,246 @@ func TestList_RandomSorts(t *testing.T) {
 	}
 }
 
+func TestList_SortByStable(t *testing.T) {
+	l := container.NewList[genfuncs.Pair[int, string]]()
+	pairs := []genfuncs.Pair[int, string]{
+		genfuncs.NewPair(2, "a"), genfuncs.NewPair(1, "b"), genfuncs.NewPair(2, "c"),
+		genfuncs.NewPair(1, "d"), genfuncs.NewPair(0, "e"), genfuncs.NewPair(2, "f"),
+	}
+	elements := make(map[string]*container.ListElement[genfuncs.Pair[int, string]])
+	for _, p := range pairs {
+		elements[p.Second] = l.AddRight(p)
+	}
+	l.SortBy(func(a, b genfuncs.Pair[int, string]) bool { return a.First < b.First })
+	var got string
+	for e := l.PeekLeft(); e != nil; e = e.Next() {
+		got += e.Value.Second
+	}
+	assert.Equal(t, "ebdacf", got)
+	for k, e := range elements {
+		assert.Equal(t, k, e.Value.Second)
+	}
+	assert.Equal(t, "e", elements["b"].Prev().Value.Second)
+	assert.Equal(t, "f", l.PeekRight().Value.Second)
+	assert.Nil(t, elements["f"].Next())
+	l.Remove(elements["a"])
+	assert.Equal(t, "c", elements["d"].Next().Value.Second)
+	assert.Equal(t, 5, l.Len())
+}
+
+func TestList_SortedInsert(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		order  genfuncs.BiFunction[int, int, bool]
+		want   []int
+	}{
+		{
+			name:  "empty",
+			order: genfuncs.OrderedLess[int],
+			want:  []int{},
+		},
+		{
+			name:   "ascending",
+			values: []int{3, 1, 2, 5, 4, 0},
+			order:  genfuncs.OrderedLess[int],
+			want:   []int{0, 1, 2, 3, 4, 5},
+		},
+		{
+			name:   "descending",
+			values: []int{3, 1, 2, 5, 4, 0},
+			order:  genfuncs.OrderedGreater[int],
+			want:   []int{5, 4, 3, 2, 1, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := container.NewList[int]()
+			for _, v := range tt.values {
+				e := l.SortedInsert(v, tt.order)
+				assert.Equal(t, v, e.Value)
+			}
+			assert.Equal(t, tt.want, []int(l.Values()))
+		})
+	}
+	l := container.NewList[genfuncs.Pair[int, string]]()
+	byFirst := func(a, b genfuncs.Pair[int, string]) bool { return a.First < b.First }
+	l.SortedInsert(genfuncs.NewPair(1, "a"), byFirst)
+	l.SortedInsert(genfuncs.NewPair(0, "b"), byFirst)
+	l.SortedInsert(genfuncs.NewPair(1, "c"), byFirst)
+	assert.Equal(t, "c", l.PeekRight().Value.Second)
+}
+
+func TestList_Insert(t *testing.T) {
+	l := container.NewList(1, 3)
+	one := l.PeekLeft()
+	two := l.InsertAfter(2, one)
+	zero := l.InsertBefore(0, one)
+	l.InsertAfter(4, l.PeekRight())
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, []int(l.Values()))
+	assert.Equal(t, 5, l.Len())
+	assert.Same(t, zero, l.PeekLeft())
+	assert.Same(t, two, one.Next())
+	other := container.NewList(9)
+	assert.Nil(t, l.InsertAfter(5, other.PeekLeft()))
+	assert.Nil(t, l.InsertBefore(5, other.PeekLeft()))
+	l.Remove(two)
+	assert.Nil(t, l.InsertAfter(5, two))
+	assert.Equal(t, []int{0, 1, 3, 4}, []int(l.Values()))
+}
+
+func TestList_Move(t *testing.T) {
+	tests := []struct {
+		name string
+		move func(l *container.List[int], e []*container.ListElement[int])
+		want []int
+	}{
+		{
+			name: "to front",
+			move: func(l *container.List[int], e []*container.ListElement[int]) { l.MoveToFront(e[2]) },
+			want: []int{2, 0, 1, 3},
+		},
+		{
+			name: "front to front",
+			move: func(l *container.List[int], e []*container.ListElement[int]) { l.MoveToFront(e[0]) },
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name: "to back",
+			move: func(l *container.List[int], e []*container.ListElement[int]) { l.MoveToBack(e[1]) },
+			want: []int{0, 2, 3, 1},
+		},
+		{
+			name: "back to back",
+			move: func(l *container.List[int], e []*container.ListElement[int]) { l.MoveToBack(e[3]) },
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name: "before",
+			move: func(l *container.List[int], e []*container.ListElement[int]) { l.MoveBefore(e[3], e[1]) },
+			want: []int{0, 3, 1, 2},
+		},
+		{
+			name: "before adjacent",
+			move: func(l *container.List[int], e []*container.ListElement[int]) { l.MoveBefore(e[0], e[1]) },
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name: "after adjacent",
+			move: func(l *container.List[int], e []*container.ListElement[int]) { l.MoveAfter(e[2], e[1]) },
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name: "after",
+			move: func(l *container.List[int], e []*container.ListElement[int]) { l.MoveAfter(e[0], e[2]) },
+			want: []int{1, 2, 0, 3},
+		},
+		{
+			name: "self",
+			move: func(l *container.List[int], e []*container.ListElement[int]) { l.MoveAfter(e[1], e[1]) },
+			want: []int{0, 1, 2, 3},
+		},
+		{
+			name: "other list",
+			move: func(l *container.List[int], e []*container.ListElement[int]) {
+				other := container.NewList(9)
+				l.MoveToFront(other.PeekLeft())
+				l.MoveBefore(e[1], other.PeekLeft())
+				other.MoveToBack(e[0])
+			},
+			want: []int{0, 1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := container.NewList[int]()
+			var elements []*container.ListElement[int]
+			for i := 0; i < 4; i++ {
+				elements = append(elements, l.AddRight(i))
+			}
+			tt.move(l, elements)
+			assert.Equal(t, tt.want, []int(l.Values()))
+			assert.Equal(t, 4, l.Len())
+			var reversed []int
+			for e := l.PeekRight(); e != nil; e = e.Prev() {
+				reversed = append(reversed, e.Value)
+			}
+			slices.Reverse(reversed)
+			assert.Equal(t, tt.want, reversed)
+		})
+	}
+}
+
+func TestList_Splice(t *testing.T) {
+	l1 := container.NewList(1, 2)
+	l2 := container.NewList(3, 4)
+	l3 := container.NewList(5)
+	three := l2.PeekLeft()
+	five := l3.PeekLeft()
+	l2.Splice(l3)
+	l1.Splice(l2)
+	l1.Splice(l1)
+	l1.Splice(container.NewList[int]())
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, []int(l1.Values()))
+	assert.Equal(t, 5, l1.Len())
+	assert.Equal(t, 0, l2.Len())
+	assert.Equal(t, 0, l3.Len())
+	assert.Nil(t, l2.PeekLeft())
+	assert.Nil(t, five.Next())
+	assert.Equal(t, 4, five.Prev().Value)
+	l2.Remove(three)
+	assert.Equal(t, 5, l1.Len())
+	l1.Remove(three)
+	assert.Equal(t, []int{1, 2, 4, 5}, []int(l1.Values()))
+	l1.MoveToFront(five)
+	assert.Equal(t, []int{5, 1, 2, 4}, []int(l1.Values()))
+	l2.Add(6)
+	l2.PushBackList(l1)
+	assert.Equal(t, []int{6, 5, 1, 2, 4}, []int(l2.Values()))
+	assert.Equal(t, 4, l1.Len())
+	l1.PushBackList(l1)
+	assert.Equal(t, []int{5, 1, 2, 4, 5, 1, 2, 4}, []int(l1.Values()))
+}
+
+func TestList_Reverse(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int
+		want   []int
+	}{
+		{name: "empty", want: []int{}},
+		{name: "one", values: []int{1}, want: []int{1}},
+		{name: "many", values: []int{1, 2, 3, 4}, want: []int{4, 3, 2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := container.NewList(tt.values...)
+			l.Reverse()
+			assert.Equal(t, tt.want, []int(l.Values()))
+			l.Add(5)
+			assert.Equal(t, 5, l.PeekRight().Value)
+		})
+	}
+}
+
+func TestList_Clear(t *testing.T) {
+	l1 := container.NewList(1, 2)
+	l2 := container.NewList(3)
+	one := l1.PeekLeft()
+	three := l2.PeekLeft()
+	l1.Splice(l2)
+	l1.Clear()
+	assert.Equal(t, 0, l1.Len())
+	assert.Nil(t, l1.PeekLeft())
+	assert.Nil(t, one.Next())
+	assert.Nil(t, three.Prev())
+	l1.Remove(one)
+	l1.MoveToFront(three)
+	assert.Equal(t, 0, l1.Len())
+	l1.Add(4)
+	assert.Equal(t, []int{4}, []int(l1.Values()))
+}
+
 func TestList_ForEach(t *testing.T) {
 	type args struct {
 		values container.GSlice[string]
@@ -364,3 +612,39 @@ func Test_listIterator_NoHasNext(t *testing.T) {
 		_ = iterator.Next()
 	})
 }
+
+func TestList_ConcurrentReaders(t *testing.T) {
+	l := container.NewList(1, 2)
+	l.Splice(container.NewList(3, 4))
+	l.Splice(container.NewList(5))
+	var wg sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var forward, backward []int
+			for e := l.PeekLeft(); e != nil; e = e.Next() {
+				forward = append(forward, e.Value)
+			}
+			for e := l.PeekRight(); e != nil; e = e.Prev() {
+				backward = append(backward, e.Value)
+			}
+			assert.Equal(t, []int{1, 2, 3, 4, 5}, forward)
+			assert.Equal(t, []int{5, 4, 3, 2, 1}, backward)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestList_All(t *testing.T) {
+	l := container.NewList(1, 2, 3)
+	var got container.GSlice[int]
+	for v := range l.All() {
+		got = append(got, v)
+	}
+	assert.Equal(t, l.Values(), got)
+	for v := range l.All() {
+		assert.Equal(t, 1, v)
+		break
+	}
+}
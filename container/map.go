@@ -16,14 +16,26 @@
 
 package container
 
-// Map interface to provide a polymorphic and generic interface to map implementations.
-type Map[K comparable, V any] interface {
-	HasValues[V]
-	Sequence[V]
-	Contains(key K) bool
-	Delete(key K)
-	Get(key K) (value V, ok bool)
-	Put(key K, value V)
-	ForEach(f func(key K, value V))
-	Keys() GSlice[K]
+type (
+	// Entry can be used to hold onto a key/value.
+	Entry[K comparable, V any] struct {
+		Key   K
+		Value V
+	}
+	// Map interface to provide a polymorphic and generic interface to map implementations.
+	Map[K comparable, V any] interface {
+		HasValues[V]
+		Sequence[V]
+		Contains(key K) bool
+		Delete(key K)
+		Get(key K) (value V, ok bool)
+		Put(key K, value V)
+		ForEach(f func(key K, value V))
+		Keys() GSlice[K]
+	}
+)
+
+// NewEntry creates an Entry for the key and value.
+func NewEntry[K comparable, V any](k K, v V) *Entry[K, V] {
+	return &Entry[K, V]{Key: k, Value: v}
 }
@@ -16,6 +16,8 @@
 
 package container
 
+import "iter"
+
 var (
 	mapNilEntry          = struct{}{}
 	_           Set[int] = (*MapSet[int])(nil)
@@ -45,6 +47,18 @@ func (h *MapSet[T]) AddAll(t ...T) {
 	}
 }
 
+// All returns an iter.Seq of the elements in the MapSet in no particular order.
+func (h *MapSet[T]) All() (seq iter.Seq[T]) {
+	seq = func(yield func(T) bool) {
+		for t := range h.set {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
 // Contains returns true if MapSet contains element.
 func (h *MapSet[T]) Contains(t T) (ok bool) {
 	_, ok = h.set[t]
@@ -54,3 +54,18 @@ func TestMapSet_Iterator(t *testing.T) {
 	}
 	assert.Equal(t, count, m.Len())
 }
+
+func TestMapSet_All(t *testing.T) {
+	set := container.NewMapSet("a", "b", "c").(*container.MapSet[string])
+	var got container.GSlice[string]
+	for v := range set.All() {
+		got = append(got, v)
+	}
+	assert.ElementsMatch(t, set.Values(), got)
+	count := 0
+	for range set.All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
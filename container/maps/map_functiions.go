@@ -44,6 +44,7 @@ func Merge[K comparable, V any](mv ...container.Map[K, container.GSlice[V]]) (re
 	return result
 }
 
+// NewEntry creates an Entry for the key and value.
 func NewEntry[K comparable, V any](k K, v V) *Entry[K, V] {
-	return &Entry[K, V]{Key: k, Value: v}
+	return container.NewEntry(k, v)
 }
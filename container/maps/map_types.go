@@ -16,14 +16,14 @@
 
 package maps
 
-import "github.com/nwillc/genfuncs"
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
 
 type (
-	// Entry can be used to hold onto a key/value.
-	Entry[K comparable, V any] struct {
-		Key   K
-		Value V
-	}
+	// Entry can be used to hold onto a key/value. Entry is an alias of container.Entry.
+	Entry[K comparable, V any] = container.Entry[K, V]
 
 	// KeyFor is used for generating keys from types, it accepts any type and returns a comparable key for it.
 	KeyFor[T any, K comparable] func(T) *genfuncs.Result[K]
@@ -0,0 +1,148 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import "github.com/nwillc/genfuncs"
+
+// MergeableHeap implements Queue.
+var _ Queue[int] = (*MergeableHeap[int])(nil)
+
+type (
+	// MergeableHeap is a pairing heap, which can be min heap or max heap depending on the compare provided, supporting
+	// constant time Meld of two MergeableHeaps. MergeableHeap implements Queue.
+	MergeableHeap[T any] struct {
+		root    *pairingNode[T]
+		length  int
+		compare genfuncs.BiFunction[T, T, bool]
+	}
+	// pairingNode holds its first child, with the remaining children linked as its child's siblings.
+	pairingNode[T any] struct {
+		value   T
+		child   *pairingNode[T]
+		sibling *pairingNode[T]
+	}
+)
+
+// NewMergeableHeap returns a MergeableHeap ordered based on the compare and adds any values provided.
+func NewMergeableHeap[T any](compare genfuncs.BiFunction[T, T, bool], values ...T) (heap *MergeableHeap[T]) {
+	heap = &MergeableHeap[T]{compare: compare}
+	heap.AddAll(values...)
+	return heap
+}
+
+// Add a value onto the MergeableHeap.
+func (h *MergeableHeap[T]) Add(value T) {
+	h.root = h.link(h.root, &pairingNode[T]{value: value})
+	h.length++
+}
+
+// AddAll the values onto the MergeableHeap.
+func (h *MergeableHeap[T]) AddAll(values ...T) {
+	for _, v := range values {
+		h.Add(v)
+	}
+}
+
+// Len returns current length of the MergeableHeap.
+func (h *MergeableHeap[T]) Len() (length int) {
+	length = h.length
+	return length
+}
+
+// Meld moves the values of the other MergeableHeap into this one in constant time, leaving the other empty. The
+// MergeableHeaps are expected to have the same compare.
+func (h *MergeableHeap[T]) Meld(other *MergeableHeap[T]) {
+	if other == h {
+		return
+	}
+	h.root = h.link(h.root, other.root)
+	h.length += other.length
+	other.root = nil
+	other.length = 0
+}
+
+// Peek returns the next element without removing it.
+func (h *MergeableHeap[T]) Peek() (value T) {
+	if h.root == nil {
+		panic(genfuncs.NoSuchElement)
+	}
+	value = h.root.value
+	return value
+}
+
+// Remove an item off the MergeableHeap.
+func (h *MergeableHeap[T]) Remove() (value T) {
+	value = h.Peek()
+	h.root = h.pair(h.root.child)
+	h.length--
+	return value
+}
+
+// Values returns a copy of the values in the MergeableHeap in no particular order.
+func (h *MergeableHeap[T]) Values() (values GSlice[T]) {
+	values = make(GSlice[T], 0, h.length)
+	var stack []*pairingNode[T]
+	if h.root != nil {
+		stack = append(stack, h.root)
+	}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		values = append(values, n.value)
+		for c := n.child; c != nil; c = c.sibling {
+			stack = append(stack, c)
+		}
+	}
+	return values
+}
+
+// link makes the root that follows in order the first child of the other, returning the resulting root.
+func (h *MergeableHeap[T]) link(a, b *pairingNode[T]) *pairingNode[T] {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	case h.compare(b.value, a.value):
+		a, b = b, a
+	}
+	b.sibling = a.child
+	a.child = b
+	return a
+}
+
+// pair combines a list of siblings into one root, linking them in pairs from the left and then linking the pairs
+// from the right.
+func (h *MergeableHeap[T]) pair(first *pairingNode[T]) *pairingNode[T] {
+	var pairs []*pairingNode[T]
+	for first != nil {
+		a, b := first, first.sibling
+		if b == nil {
+			first = nil
+		} else {
+			first = b.sibling
+			b.sibling = nil
+		}
+		a.sibling = nil
+		pairs = append(pairs, h.link(a, b))
+	}
+	var root *pairingNode[T]
+	for i := len(pairs) - 1; i >= 0; i-- {
+		root = h.link(pairs[i], root)
+	}
+	return root
+}
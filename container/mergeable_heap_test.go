@@ -0,0 +1,95 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewMergeableHeap(t *testing.T) {
+	heap := container.NewMergeableHeap[int](genfuncs.OrderedLess[int])
+	assert.Equal(t, 0, heap.Len())
+	assert.Empty(t, heap.Values())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = heap.Peek() })
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = heap.Remove() })
+}
+
+func TestMergeableHeap(t *testing.T) {
+	tests := []struct {
+		name   string
+		order  genfuncs.BiFunction[int, int, bool]
+		values []int
+		want   []int
+	}{
+		{
+			name:   "min",
+			order:  genfuncs.OrderedLess[int],
+			values: []int{3, 4, 1, 2, 1},
+			want:   []int{1, 1, 2, 3, 4},
+		},
+		{
+			name:   "max",
+			order:  genfuncs.OrderedGreater[int],
+			values: []int{3, 1, 2},
+			want:   []int{3, 2, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			heap := container.NewMergeableHeap(tt.order, tt.values...)
+			assert.Equal(t, len(tt.want), heap.Len())
+			assert.ElementsMatch(t, tt.want, heap.Values())
+			for _, want := range tt.want {
+				assert.Equal(t, want, heap.Peek())
+				assert.Equal(t, want, heap.Remove())
+			}
+			assert.Equal(t, 0, heap.Len())
+		})
+	}
+}
+
+func TestMergeableHeap_Meld(t *testing.T) {
+	random := rand.New(rand.NewSource(time.Now().Unix()))
+	heap := container.NewMergeableHeap[int](genfuncs.OrderedLess[int])
+	var want []int
+	for shard := 0; shard < 10; shard++ {
+		other := container.NewMergeableHeap[int](genfuncs.OrderedLess[int])
+		for i := random.Intn(200); i > 0; i-- {
+			v := random.Intn(1000)
+			other.Add(v)
+			want = append(want, v)
+		}
+		heap.Meld(other)
+		assert.Equal(t, 0, other.Len())
+		assert.Equal(t, len(want), heap.Len())
+	}
+	heap.Meld(heap)
+	heap.Meld(container.NewMergeableHeap[int](genfuncs.OrderedLess[int]))
+	assert.Equal(t, len(want), heap.Len())
+	slices.Sort(want)
+	var got []int
+	for heap.Len() > 0 {
+		got = append(got, heap.Remove())
+	}
+	assert.Equal(t, want, got)
+}
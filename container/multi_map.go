@@ -0,0 +1,245 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import "iter"
+
+var (
+	_ HasValues[int]                = (*MultiMap[int, int])(nil)
+	_ Sequence[int]                 = (*MultiMap[int, int])(nil)
+	_ multiMapValues[int]           = (*MapSet[int])(nil)
+	_ multiMapValues[int]           = (*multiMapSlice[int])(nil)
+	_ Sequence[*Entry[string, int]] = (*multiMapEntries[string, int])(nil)
+)
+
+type (
+	// MultiMap maps keys to one or more values. Values for a key are held either in a slice, retaining duplicates
+	// and insertion order, or in a set. A key is present while it has at least one value.
+	MultiMap[K comparable, V comparable] struct {
+		index     GMap[K, multiMapValues[V]]
+		newValues func() multiMapValues[V]
+		length    int
+	}
+	// multiMapValues holds the values for a key in a MultiMap.
+	multiMapValues[V comparable] interface {
+		Container[V]
+		Sequence[V]
+		Contains(v V) bool
+		Remove(v V)
+	}
+	multiMapSlice[V comparable] struct {
+		slice GSlice[V]
+	}
+	multiMapEntries[K comparable, V comparable] struct {
+		multiMap *MultiMap[K, V]
+	}
+)
+
+// NewMultiMap creates a MultiMap holding the values for each key in a slice, allowing duplicates and retaining
+// insertion order.
+func NewMultiMap[K comparable, V comparable]() (multiMap *MultiMap[K, V]) {
+	multiMap = &MultiMap[K, V]{
+		index:     make(GMap[K, multiMapValues[V]]),
+		newValues: func() multiMapValues[V] { return &multiMapSlice[V]{} },
+	}
+	return multiMap
+}
+
+// NewSetMultiMap creates a MultiMap holding the values for each key in a set, ignoring duplicates.
+func NewSetMultiMap[K comparable, V comparable]() (multiMap *MultiMap[K, V]) {
+	multiMap = &MultiMap[K, V]{
+		index:     make(GMap[K, multiMapValues[V]]),
+		newValues: func() multiMapValues[V] { return &MapSet[V]{set: make(GMap[V, struct{}])} },
+	}
+	return multiMap
+}
+
+// All returns an iter.Seq2 of the key value pairs in the MultiMap, grouped by key.
+func (m *MultiMap[K, V]) All() (seq iter.Seq2[K, V]) {
+	seq = func(yield func(K, V) bool) {
+		for k, values := range m.index {
+			for iterator := values.Iterator(); iterator.HasNext(); {
+				if !yield(k, iterator.Next()) {
+					return
+				}
+			}
+		}
+	}
+	return seq
+}
+
+// AsMap returns a GMap of the keys to a GSlice of their values.
+func (m *MultiMap[K, V]) AsMap() (result GMap[K, GSlice[V]]) {
+	result = make(GMap[K, GSlice[V]], m.index.Len())
+	for k, values := range m.index {
+		result[k] = values.Values()
+	}
+	return result
+}
+
+// Contains returns true if the MultiMap contains the key.
+func (m *MultiMap[K, V]) Contains(key K) (contains bool) {
+	contains = m.index.Contains(key)
+	return contains
+}
+
+// ContainsEntry returns true if the MultiMap contains the value for the key.
+func (m *MultiMap[K, V]) ContainsEntry(key K, value V) (contains bool) {
+	values, ok := m.index[key]
+	contains = ok && values.Contains(value)
+	return contains
+}
+
+// Entries returns a Sequence of the key value pairs in the MultiMap, grouped by key.
+func (m *MultiMap[K, V]) Entries() (entries Sequence[*Entry[K, V]]) {
+	entries = &multiMapEntries[K, V]{multiMap: m}
+	return entries
+}
+
+// ForEach traverses the key value pairs in the MultiMap applying the given function.
+func (m *MultiMap[K, V]) ForEach(f func(key K, value V)) {
+	for k, v := range m.All() {
+		f(k, v)
+	}
+}
+
+// GetAll returns a copy of the values for the key, empty if the key is not contained.
+func (m *MultiMap[K, V]) GetAll(key K) (values GSlice[V]) {
+	if v, ok := m.index[key]; ok {
+		values = v.Values()
+		return values
+	}
+	values = GSlice[V]{}
+	return values
+}
+
+// Iterator returns an Iterator over a copy of the values in the MultiMap.
+func (m *MultiMap[K, V]) Iterator() Iterator[V] {
+	return m.Values().Iterator()
+}
+
+// KeyLen returns the number of distinct keys in the MultiMap.
+func (m *MultiMap[K, V]) KeyLen() (length int) {
+	length = m.index.Len()
+	return length
+}
+
+// Keys returns the distinct keys in the MultiMap.
+func (m *MultiMap[K, V]) Keys() (keys GSlice[K]) {
+	keys = m.index.Keys()
+	return keys
+}
+
+// Len returns the number of key value pairs in the MultiMap.
+func (m *MultiMap[K, V]) Len() (length int) {
+	length = m.length
+	return length
+}
+
+// Put adds the values for the key.
+func (m *MultiMap[K, V]) Put(key K, values ...V) {
+	current, ok := m.index[key]
+	if !ok {
+		current = m.newValues()
+		m.index[key] = current
+	}
+	before := current.Len()
+	current.AddAll(values...)
+	m.length += current.Len() - before
+	if current.Len() == 0 {
+		delete(m.index, key)
+	}
+}
+
+// Remove a value for the key, returning true if it was present. With slice backed values the first occurrence is
+// removed.
+func (m *MultiMap[K, V]) Remove(key K, value V) (removed bool) {
+	values, ok := m.index[key]
+	if !ok || !values.Contains(value) {
+		return false
+	}
+	values.Remove(value)
+	m.length--
+	if values.Len() == 0 {
+		delete(m.index, key)
+	}
+	return true
+}
+
+// RemoveAll the values for the key, returning them.
+func (m *MultiMap[K, V]) RemoveAll(key K) (values GSlice[V]) {
+	values = m.GetAll(key)
+	m.length -= values.Len()
+	delete(m.index, key)
+	return values
+}
+
+// Values returns all the values in the MultiMap, grouped by key.
+func (m *MultiMap[K, V]) Values() (values GSlice[V]) {
+	values = make(GSlice[V], 0, m.length)
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+func (s *multiMapSlice[V]) Add(v V) {
+	s.slice = append(s.slice, v)
+}
+
+func (s *multiMapSlice[V]) AddAll(v ...V) {
+	s.slice = append(s.slice, v...)
+}
+
+func (s *multiMapSlice[V]) Contains(v V) bool {
+	return s.index(v) >= 0
+}
+
+func (s *multiMapSlice[V]) Iterator() Iterator[V] {
+	return s.slice.Iterator()
+}
+
+func (s *multiMapSlice[V]) Len() int {
+	return s.slice.Len()
+}
+
+func (s *multiMapSlice[V]) Remove(v V) {
+	if i := s.index(v); i >= 0 {
+		s.slice = append(s.slice[:i], s.slice[i+1:]...)
+	}
+}
+
+func (s *multiMapSlice[V]) Values() GSlice[V] {
+	return append(GSlice[V]{}, s.slice...)
+}
+
+func (s *multiMapSlice[V]) index(v V) int {
+	for i, e := range s.slice {
+		if e == v {
+			return i
+		}
+	}
+	return -1
+}
+
+func (e *multiMapEntries[K, V]) Iterator() Iterator[*Entry[K, V]] {
+	entries := make(GSlice[*Entry[K, V]], 0, e.multiMap.Len())
+	for k, v := range e.multiMap.All() {
+		entries = append(entries, NewEntry(k, v))
+	}
+	return entries.Iterator()
+}
@@ -0,0 +1,99 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs/container"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewMultiMap(t *testing.T) {
+	m := container.NewMultiMap[string, int]()
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, 0, m.KeyLen())
+	assert.False(t, m.Iterator().HasNext())
+	assert.False(t, m.Entries().Iterator().HasNext())
+	assert.Equal(t, []int{}, []int(m.GetAll("a")))
+}
+
+func TestMultiMap(t *testing.T) {
+	tests := []struct {
+		name        string
+		multiMap    *container.MultiMap[string, int]
+		wantA       []int
+		wantLen     int
+		wantRemoved []int
+	}{
+		{
+			name:        "slices",
+			multiMap:    container.NewMultiMap[string, int](),
+			wantA:       []int{1, 2, 1},
+			wantLen:     5,
+			wantRemoved: []int{2, 1},
+		},
+		{
+			name:        "sets",
+			multiMap:    container.NewSetMultiMap[string, int](),
+			wantA:       []int{1, 2},
+			wantLen:     4,
+			wantRemoved: []int{2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := tt.multiMap
+			m.Put("a", 1, 2)
+			m.Put("a", 1)
+			m.Put("b", 3, 4)
+			m.Put("c")
+			assert.Equal(t, tt.wantLen, m.Len())
+			assert.Equal(t, 2, m.KeyLen())
+			assert.ElementsMatch(t, []string{"a", "b"}, m.Keys())
+			assert.False(t, m.Contains("c"))
+			assert.ElementsMatch(t, tt.wantA, m.GetAll("a"))
+			assert.True(t, m.ContainsEntry("a", 2))
+			assert.False(t, m.ContainsEntry("a", 3))
+			assert.Len(t, m.Values(), tt.wantLen)
+			assert.Len(t, m.AsMap(), 2)
+
+			count := 0
+			m.ForEach(func(k string, v int) {
+				assert.True(t, m.ContainsEntry(k, v))
+				count++
+			})
+			assert.Equal(t, tt.wantLen, count)
+			count = 0
+			for iterator := m.Entries().Iterator(); iterator.HasNext(); count++ {
+				e := iterator.Next()
+				assert.True(t, m.ContainsEntry(e.Key, e.Value))
+			}
+			assert.Equal(t, tt.wantLen, count)
+
+			assert.True(t, m.Remove("a", 1))
+			assert.False(t, m.Remove("a", 5))
+			assert.False(t, m.Remove("z", 1))
+			assert.ElementsMatch(t, tt.wantRemoved, m.GetAll("a"))
+			assert.True(t, m.Remove("b", 3))
+			assert.True(t, m.Remove("b", 4))
+			assert.False(t, m.Contains("b"))
+			assert.ElementsMatch(t, tt.wantRemoved, m.RemoveAll("a"))
+			assert.Equal(t, 0, m.Len())
+			assert.Equal(t, 0, m.KeyLen())
+		})
+	}
+}
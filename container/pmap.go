@@ -0,0 +1,393 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"github.com/nwillc/genfuncs"
+	"hash/maphash"
+	"iter"
+	"math/bits"
+)
+
+var (
+	_ HasValues[int] = (*PMap[int, int])(nil)
+	_ Sequence[int]  = (*PMap[int, int])(nil)
+	_ Iterator[int]  = (*pmapIterator[int, int])(nil)
+)
+
+type (
+	// PMap is an immutable map implemented as a hash array mapped trie. Modifications return a new PMap sharing
+	// structure with the original. PMap implements HasValues and Sequence.
+	PMap[K comparable, V any] struct {
+		root   *pmapNode[K, V]
+		length int
+		seed   maphash.Seed
+	}
+	// PMapBuilder is a transient PMap that is modified in place, for efficient batch construction.
+	PMapBuilder[K comparable, V any] struct {
+		root   *pmapNode[K, V]
+		length int
+		seed   maphash.Seed
+		owner  *persistentOwner
+	}
+	// pmapNode holds a slot for each bit set in its bitmap, ordered by bit. Below the last level of hash bits a node
+	// holds colliding entries in any order.
+	pmapNode[K comparable, V any] struct {
+		bitmap uint32
+		slots  []pmapSlot[K, V]
+		owner  *persistentOwner
+	}
+	// pmapSlot is either an entry, or when node is not nil a sub trie.
+	pmapSlot[K comparable, V any] struct {
+		hash  uint64
+		key   K
+		value V
+		node  *pmapNode[K, V]
+	}
+	pmapIterator[K comparable, V any] struct {
+		stack []pmapFrame[K, V]
+		next  *pmapSlot[K, V]
+	}
+	pmapFrame[K comparable, V any] struct {
+		node  *pmapNode[K, V]
+		index int
+	}
+)
+
+// NewPMap creates a new empty PMap.
+func NewPMap[K comparable, V any]() (pMap *PMap[K, V]) {
+	pMap = &PMap[K, V]{root: &pmapNode[K, V]{}, seed: maphash.MakeSeed()}
+	return pMap
+}
+
+// All returns an iter.Seq2 of the keys and values of the PMap in no particular order.
+func (m *PMap[K, V]) All() (seq iter.Seq2[K, V]) {
+	seq = func(yield func(K, V) bool) {
+		m.root.walk(yield)
+	}
+	return seq
+}
+
+// Contains returns true if the PMap contains the given key.
+func (m *PMap[K, V]) Contains(key K) (contains bool) {
+	_, contains = m.Get(key)
+	return contains
+}
+
+// ForEach traverses the PMap applying the given function to all entries.
+func (m *PMap[K, V]) ForEach(f func(key K, value V)) {
+	for k, v := range m.All() {
+		f(k, v)
+	}
+}
+
+// Get the value for the key. The returned ok value will be false if the key is not contained in the PMap.
+func (m *PMap[K, V]) Get(key K) (value V, ok bool) {
+	value, ok = m.root.get(maphash.Comparable(m.seed, key), key, 0)
+	return value, ok
+}
+
+// Iterator returns an Iterator over the values of the PMap in no particular order.
+func (m *PMap[K, V]) Iterator() Iterator[V] {
+	return newPMapIterator(m.root)
+}
+
+// Keys returns the keys of the PMap in no particular order.
+func (m *PMap[K, V]) Keys() (keys GSlice[K]) {
+	keys = make(GSlice[K], 0, m.length)
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Len returns the number of entries in the PMap.
+func (m *PMap[K, V]) Len() (length int) {
+	length = m.length
+	return length
+}
+
+// Transient returns a PMapBuilder initialized with the entries of the PMap, which is unaffected by its changes.
+func (m *PMap[K, V]) Transient() (builder *PMapBuilder[K, V]) {
+	builder = &PMapBuilder[K, V]{root: m.root, length: m.length, seed: m.seed, owner: &persistentOwner{}}
+	return builder
+}
+
+// Values returns the values of the PMap in no particular order.
+func (m *PMap[K, V]) Values() (values GSlice[V]) {
+	values = make(GSlice[V], 0, m.length)
+	for _, v := range m.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// With returns a PMap with the key value pair added, replacing the value of an existing key.
+func (m *PMap[K, V]) With(key K, value V) (pMap *PMap[K, V]) {
+	root, added := m.root.put(nil, maphash.Comparable(m.seed, key), key, value, 0)
+	pMap = &PMap[K, V]{root: root, length: m.length, seed: m.seed}
+	if added {
+		pMap.length++
+	}
+	return pMap
+}
+
+// Without returns a PMap with the key removed.
+func (m *PMap[K, V]) Without(key K) (pMap *PMap[K, V]) {
+	root, removed := m.root.remove(nil, maphash.Comparable(m.seed, key), key, 0)
+	if !removed {
+		return m
+	}
+	pMap = &PMap[K, V]{root: root, length: m.length - 1, seed: m.seed}
+	return pMap
+}
+
+// Build returns a PMap of the entries of the PMapBuilder. Later changes to the PMapBuilder do not affect the PMap.
+func (b *PMapBuilder[K, V]) Build() (pMap *PMap[K, V]) {
+	pMap = &PMap[K, V]{root: b.root, length: b.length, seed: b.seed}
+	b.owner = &persistentOwner{}
+	return pMap
+}
+
+// Delete an entry from the PMapBuilder.
+func (b *PMapBuilder[K, V]) Delete(key K) {
+	var removed bool
+	b.root, removed = b.root.remove(b.owner, maphash.Comparable(b.seed, key), key, 0)
+	if removed {
+		b.length--
+	}
+}
+
+// Get the value for the key. The returned ok value will be false if the key is not contained in the PMapBuilder.
+func (b *PMapBuilder[K, V]) Get(key K) (value V, ok bool) {
+	value, ok = b.root.get(maphash.Comparable(b.seed, key), key, 0)
+	return value, ok
+}
+
+// Len returns the number of entries in the PMapBuilder.
+func (b *PMapBuilder[K, V]) Len() (length int) {
+	length = b.length
+	return length
+}
+
+// Put a key value pair into the PMapBuilder, replacing the value of an existing key.
+func (b *PMapBuilder[K, V]) Put(key K, value V) {
+	var added bool
+	b.root, added = b.root.put(b.owner, maphash.Comparable(b.seed, key), key, value, 0)
+	if added {
+		b.length++
+	}
+}
+
+// pmapCollision returns true if the shift has consumed all hash bits.
+func pmapCollision(shift uint) bool {
+	return shift >= 64
+}
+
+// position returns the bitmap bit for the hash at the shift and the index of its slot.
+func (n *pmapNode[K, V]) position(hash uint64, shift uint) (bit uint32, index int) {
+	bit = 1 << ((hash >> shift) & persistentMask)
+	index = bits.OnesCount32(n.bitmap & (bit - 1))
+	return bit, index
+}
+
+// editable returns the node if owned by the owner, otherwise a copy owned by the owner.
+func (n *pmapNode[K, V]) editable(owner *persistentOwner) *pmapNode[K, V] {
+	if owner != nil && n.owner == owner {
+		return n
+	}
+	slots := make([]pmapSlot[K, V], len(n.slots), len(n.slots)+1)
+	copy(slots, n.slots)
+	return &pmapNode[K, V]{bitmap: n.bitmap, slots: slots, owner: owner}
+}
+
+func (n *pmapNode[K, V]) get(hash uint64, key K, shift uint) (value V, ok bool) {
+	for {
+		if pmapCollision(shift) {
+			for _, s := range n.slots {
+				if s.key == key {
+					return s.value, true
+				}
+			}
+			return value, false
+		}
+		bit, index := n.position(hash, shift)
+		if n.bitmap&bit == 0 {
+			return value, false
+		}
+		s := &n.slots[index]
+		if s.node == nil {
+			if s.key == key {
+				return s.value, true
+			}
+			return value, false
+		}
+		n, shift = s.node, shift+persistentBits
+	}
+}
+
+func (n *pmapNode[K, V]) put(owner *persistentOwner, hash uint64, key K, value V, shift uint) (result *pmapNode[K, V], added bool) {
+	entry := pmapSlot[K, V]{hash: hash, key: key, value: value}
+	if pmapCollision(shift) {
+		result = n.editable(owner)
+		for i := range result.slots {
+			if result.slots[i].key == key {
+				result.slots[i].value = value
+				return result, false
+			}
+		}
+		result.slots = append(result.slots, entry)
+		return result, true
+	}
+	bit, index := n.position(hash, shift)
+	result = n.editable(owner)
+	if n.bitmap&bit == 0 {
+		result.bitmap |= bit
+		result.slots = append(result.slots, pmapSlot[K, V]{})
+		copy(result.slots[index+1:], result.slots[index:])
+		result.slots[index] = entry
+		return result, true
+	}
+	s := &result.slots[index]
+	switch {
+	case s.node != nil:
+		s.node, added = s.node.put(owner, hash, key, value, shift+persistentBits)
+	case s.key == key:
+		s.value = value
+	default:
+		*s = pmapSlot[K, V]{node: pmapMerge(owner, *s, entry, shift+persistentBits)}
+		added = true
+	}
+	return result, added
+}
+
+func (n *pmapNode[K, V]) remove(owner *persistentOwner, hash uint64, key K, shift uint) (result *pmapNode[K, V], removed bool) {
+	if pmapCollision(shift) {
+		for i := range n.slots {
+			if n.slots[i].key == key {
+				result = n.editable(owner)
+				result.slots = append(result.slots[:i], result.slots[i+1:]...)
+				return result, true
+			}
+		}
+		return n, false
+	}
+	bit, index := n.position(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	s := n.slots[index]
+	if s.node == nil {
+		if s.key != key {
+			return n, false
+		}
+		result = n.editable(owner)
+		result.bitmap &^= bit
+		result.slots = append(result.slots[:index], result.slots[index+1:]...)
+		return result, true
+	}
+	child, removed := s.node.remove(owner, hash, key, shift+persistentBits)
+	if !removed {
+		return n, false
+	}
+	result = n.editable(owner)
+	switch {
+	case len(child.slots) == 0:
+		result.bitmap &^= bit
+		result.slots = append(result.slots[:index], result.slots[index+1:]...)
+	case len(child.slots) == 1 && child.slots[0].node == nil:
+		result.slots[index] = child.slots[0]
+	default:
+		result.slots[index].node = child
+	}
+	return result, true
+}
+
+// walk yields the entries at and below the node until yield returns false.
+func (n *pmapNode[K, V]) walk(yield func(K, V) bool) bool {
+	for i := range n.slots {
+		s := &n.slots[i]
+		if s.node != nil {
+			if !s.node.walk(yield) {
+				return false
+			}
+		} else if !yield(s.key, s.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// pmapMerge creates a node at the shift holding the two entries.
+func pmapMerge[K comparable, V any](owner *persistentOwner, e1, e2 pmapSlot[K, V], shift uint) *pmapNode[K, V] {
+	n := &pmapNode[K, V]{owner: owner}
+	if pmapCollision(shift) {
+		n.slots = []pmapSlot[K, V]{e1, e2}
+		return n
+	}
+	bit1, _ := n.position(e1.hash, shift)
+	bit2, _ := n.position(e2.hash, shift)
+	switch {
+	case bit1 == bit2:
+		n.slots = []pmapSlot[K, V]{{node: pmapMerge(owner, e1, e2, shift+persistentBits)}}
+	case bit1 < bit2:
+		n.slots = []pmapSlot[K, V]{e1, e2}
+	default:
+		n.slots = []pmapSlot[K, V]{e2, e1}
+	}
+	n.bitmap = bit1 | bit2
+	return n
+}
+
+func newPMapIterator[K comparable, V any](root *pmapNode[K, V]) *pmapIterator[K, V] {
+	iterator := &pmapIterator[K, V]{stack: []pmapFrame[K, V]{{node: root}}}
+	iterator.advance()
+	return iterator
+}
+
+func (i *pmapIterator[K, V]) HasNext() bool {
+	return i.next != nil
+}
+
+func (i *pmapIterator[K, V]) Next() (value V) {
+	if !i.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	value = i.next.value
+	i.advance()
+	return value
+}
+
+// advance finds the next entry depth first.
+func (i *pmapIterator[K, V]) advance() {
+	i.next = nil
+	for len(i.stack) > 0 {
+		top := &i.stack[len(i.stack)-1]
+		if top.index >= len(top.node.slots) {
+			i.stack = i.stack[:len(i.stack)-1]
+			continue
+		}
+		s := &top.node.slots[top.index]
+		top.index++
+		if s.node != nil {
+			i.stack = append(i.stack, pmapFrame[K, V]{node: s.node})
+			continue
+		}
+		i.next = s
+		return
+	}
+}
@@ -0,0 +1,124 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/stretchr/testify/assert"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewPMap(t *testing.T) {
+	m := container.NewPMap[string, int]()
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.Contains("a"))
+	assert.False(t, m.Iterator().HasNext())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = m.Iterator().Next() })
+}
+
+func TestPMap_WithWithout(t *testing.T) {
+	m0 := container.NewPMap[string, int]()
+	m1 := m0.With("a", 1)
+	m2 := m1.With("b", 2)
+	m3 := m2.With("a", 3)
+	m4 := m3.Without("b")
+	assert.Same(t, m4, m4.Without("z"))
+	tests := []struct {
+		name string
+		pMap *container.PMap[string, int]
+		want map[string]int
+	}{
+		{name: "empty", pMap: m0, want: map[string]int{}},
+		{name: "one", pMap: m1, want: map[string]int{"a": 1}},
+		{name: "two", pMap: m2, want: map[string]int{"a": 1, "b": 2}},
+		{name: "replaced", pMap: m3, want: map[string]int{"a": 3, "b": 2}},
+		{name: "removed", pMap: m4, want: map[string]int{"a": 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, len(tt.want), tt.pMap.Len())
+			assert.Equal(t, tt.want, maps.Collect(tt.pMap.All()))
+			for k, v := range tt.want {
+				got, ok := tt.pMap.Get(k)
+				assert.True(t, ok)
+				assert.Equal(t, v, got)
+			}
+			assert.ElementsMatch(t, slices.Collect(maps.Values(tt.want)), []int(tt.pMap.Values()))
+			assert.ElementsMatch(t, slices.Collect(maps.Keys(tt.want)), []string(tt.pMap.Keys()))
+			sum, count := 0, 0
+			tt.pMap.ForEach(func(_ string, v int) { sum += v })
+			for iterator := tt.pMap.Iterator(); iterator.HasNext(); count++ {
+				sum -= iterator.Next()
+			}
+			assert.Equal(t, 0, sum)
+			assert.Equal(t, len(tt.want), count)
+		})
+	}
+}
+
+func TestPMap_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(time.Now().Unix()))
+	pMaps := []*container.PMap[int, int]{container.NewPMap[int, int]()}
+	wants := []map[int]int{{}}
+	for i := 0; i < 3000; i++ {
+		last := pMaps[len(pMaps)-1]
+		want := maps.Clone(wants[len(wants)-1])
+		k := random.Intn(1000)
+		if random.Intn(3) == 0 {
+			last = last.Without(k)
+			delete(want, k)
+		} else {
+			last = last.With(k, i)
+			want[k] = i
+		}
+		pMaps = append(pMaps, last)
+		wants = append(wants, want)
+	}
+	for i := 0; i < len(pMaps); i += 97 {
+		assert.Equal(t, wants[i], maps.Collect(pMaps[i].All()))
+		assert.Equal(t, len(wants[i]), pMaps[i].Len())
+	}
+}
+
+func TestPMapBuilder(t *testing.T) {
+	m := container.NewPMap[int, int]().With(1, 1)
+	builder := m.Transient()
+	for i := 0; i < 100; i++ {
+		builder.Put(i, i*i)
+	}
+	builder.Delete(50)
+	builder.Delete(1000)
+	assert.Equal(t, 99, builder.Len())
+	v, ok := builder.Get(9)
+	assert.True(t, ok)
+	assert.Equal(t, 81, v)
+	built := builder.Build()
+	builder.Put(1, -1)
+	builder.Delete(2)
+	assert.Equal(t, map[int]int{1: 1}, maps.Collect(m.All()))
+	assert.Equal(t, 99, built.Len())
+	v, _ = built.Get(1)
+	assert.Equal(t, 1, v)
+	assert.True(t, built.Contains(2))
+	assert.False(t, built.Contains(50))
+	assert.Equal(t, 98, builder.Build().Len())
+}
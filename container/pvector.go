@@ -0,0 +1,380 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"github.com/nwillc/genfuncs"
+	"iter"
+)
+
+var (
+	_ HasValues[int] = (*PVector[int])(nil)
+	_ Sequence[int]  = (*PVector[int])(nil)
+	_ Iterator[int]  = (*pvectorIterator[int])(nil)
+)
+
+type (
+	// PVector is an immutable indexed sequence implemented as a wide trie with the trailing elements held in a tail.
+	// Modifications return a new PVector sharing structure with the original. PVector implements HasValues and
+	// Sequence.
+	PVector[T any] struct {
+		length int
+		shift  uint
+		root   *pvectorNode[T]
+		tail   []T
+	}
+	// PVectorBuilder is a transient PVector that is modified in place, for efficient batch construction.
+	PVectorBuilder[T any] struct {
+		vector     PVector[T]
+		owner      *persistentOwner
+		tailShared bool
+	}
+	// pvectorNode is a branch holding children or a leaf holding values.
+	pvectorNode[T any] struct {
+		children []*pvectorNode[T]
+		values   []T
+		owner    *persistentOwner
+	}
+	pvectorIterator[T any] struct {
+		vector *PVector[T]
+		index  int
+		values []T
+	}
+)
+
+// NewPVector creates a PVector containing the given values.
+func NewPVector[T any](values ...T) (vector *PVector[T]) {
+	builder := (&PVector[T]{shift: persistentBits, root: &pvectorNode[T]{}}).Transient()
+	builder.AddAll(values...)
+	vector = builder.Build()
+	return vector
+}
+
+// All returns an iter.Seq of the elements of the PVector in index order.
+func (v *PVector[T]) All() (seq iter.Seq[T]) {
+	seq = func(yield func(T) bool) {
+		for iterator := v.Iterator(); iterator.HasNext(); {
+			if !yield(iterator.Next()) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
+// Get returns the element at the index, panicking with NoSuchElement if out of range.
+func (v *PVector[T]) Get(index int) (value T) {
+	value = v.leaf(index)[index&persistentMask]
+	return value
+}
+
+// Iterator returns an Iterator over the elements of the PVector in index order.
+func (v *PVector[T]) Iterator() Iterator[T] {
+	return &pvectorIterator[T]{vector: v}
+}
+
+// Len returns the number of elements in the PVector.
+func (v *PVector[T]) Len() (length int) {
+	length = v.length
+	return length
+}
+
+// Set returns a PVector with the element at the index replaced, or appended if the index is the length. Set panics
+// with NoSuchElement if the index is out of range.
+func (v *PVector[T]) Set(index int, value T) (vector *PVector[T]) {
+	if index == v.length {
+		vector = v.With(value)
+		return vector
+	}
+	vector = v.clone()
+	vector.set(nil, index, value)
+	return vector
+}
+
+// Transient returns a PVectorBuilder initialized with the elements of the PVector, which is unaffected by its
+// changes.
+func (v *PVector[T]) Transient() (builder *PVectorBuilder[T]) {
+	builder = &PVectorBuilder[T]{vector: *v, owner: &persistentOwner{}, tailShared: true}
+	return builder
+}
+
+// Values returns the elements of the PVector in index order.
+func (v *PVector[T]) Values() (values GSlice[T]) {
+	values = make(GSlice[T], 0, v.length)
+	for t := range v.All() {
+		values = append(values, t)
+	}
+	return values
+}
+
+// With returns a PVector with the value appended.
+func (v *PVector[T]) With(value T) (vector *PVector[T]) {
+	vector = v.clone()
+	if len(v.tail) == persistentWidth {
+		vector.pushTail(nil, v.tail)
+		vector.tail = []T{value}
+	} else {
+		vector.tail = append(v.tail[:len(v.tail):len(v.tail)], value)
+	}
+	vector.length++
+	return vector
+}
+
+// Without returns a PVector with the last element removed, panicking with NoSuchElement if the PVector is empty.
+func (v *PVector[T]) Without() (vector *PVector[T]) {
+	vector = v.clone()
+	vector.pop(nil)
+	return vector
+}
+
+// Add a value to the end of the PVectorBuilder.
+func (b *PVectorBuilder[T]) Add(value T) {
+	v := &b.vector
+	b.editableTail()
+	if len(v.tail) == persistentWidth {
+		v.pushTail(b.owner, v.tail)
+		v.tail = make([]T, 0, persistentWidth)
+	}
+	v.tail = append(v.tail, value)
+	v.length++
+}
+
+// AddAll values to the end of the PVectorBuilder.
+func (b *PVectorBuilder[T]) AddAll(values ...T) {
+	for _, value := range values {
+		b.Add(value)
+	}
+}
+
+// Build returns a PVector of the elements of the PVectorBuilder. Later changes to the PVectorBuilder do not affect
+// the PVector.
+func (b *PVectorBuilder[T]) Build() (vector *PVector[T]) {
+	vector = b.vector.clone()
+	b.owner = &persistentOwner{}
+	b.tailShared = true
+	return vector
+}
+
+// Get returns the element at the index, panicking with NoSuchElement if out of range.
+func (b *PVectorBuilder[T]) Get(index int) (value T) {
+	value = b.vector.Get(index)
+	return value
+}
+
+// Len returns the number of elements in the PVectorBuilder.
+func (b *PVectorBuilder[T]) Len() (length int) {
+	length = b.vector.length
+	return length
+}
+
+// RemoveLast removes the last element, panicking with NoSuchElement if the PVectorBuilder is empty.
+func (b *PVectorBuilder[T]) RemoveLast() {
+	b.editableTail()
+	b.vector.pop(b.owner)
+	if len(b.vector.tail) == persistentWidth || b.vector.length == 0 {
+		b.tailShared = true
+	}
+}
+
+// Set the element at the index, panicking with NoSuchElement if out of range.
+func (b *PVectorBuilder[T]) Set(index int, value T) {
+	if index >= b.vector.tailOffset() {
+		b.editableTail()
+	}
+	b.vector.set(b.owner, index, value)
+}
+
+// editableTail copies the tail if it may be shared with a PVector.
+func (b *PVectorBuilder[T]) editableTail() {
+	if b.tailShared {
+		tail := make([]T, len(b.vector.tail), persistentWidth)
+		copy(tail, b.vector.tail)
+		b.vector.tail = tail
+		b.tailShared = false
+	}
+}
+
+func (v *PVector[T]) clone() *PVector[T] {
+	clone := *v
+	return &clone
+}
+
+// leaf returns the values of the leaf, or the tail, holding the index.
+func (v *PVector[T]) leaf(index int) []T {
+	if index < 0 || index >= v.length {
+		panic(genfuncs.NoSuchElement)
+	}
+	if index >= v.tailOffset() {
+		return v.tail
+	}
+	n := v.root
+	for level := v.shift; level > 0; level -= persistentBits {
+		n = n.children[(index>>level)&persistentMask]
+	}
+	return n.values
+}
+
+// pop removes the last element, taking a new tail from the trie when the tail empties.
+func (v *PVector[T]) pop(owner *persistentOwner) {
+	switch {
+	case v.length == 0:
+		panic(genfuncs.NoSuchElement)
+	case v.length == 1:
+		*v = PVector[T]{shift: persistentBits, root: &pvectorNode[T]{}}
+		return
+	case len(v.tail) > 1:
+		if owner == nil {
+			v.tail = v.tail[: len(v.tail)-1 : len(v.tail)-1]
+		} else {
+			v.tail = v.tail[:len(v.tail)-1]
+		}
+		v.length--
+		return
+	}
+	v.tail = v.leaf(v.length - 2)
+	root := v.popTail(owner, v.shift, v.root)
+	if root == nil {
+		root = &pvectorNode[T]{owner: owner}
+	}
+	if v.shift > persistentBits && len(root.children) == 1 {
+		root = root.children[0]
+		v.shift -= persistentBits
+	}
+	v.root = root
+	v.length--
+}
+
+func (v *PVector[T]) popTail(owner *persistentOwner, level uint, n *pvectorNode[T]) *pvectorNode[T] {
+	index := ((v.length - 2) >> level) & persistentMask
+	if level > persistentBits {
+		child := v.popTail(owner, level-persistentBits, n.children[index])
+		if child == nil && index == 0 {
+			return nil
+		}
+		result := n.editable(owner)
+		if child == nil {
+			result.children = result.children[:index]
+		} else {
+			result.children[index] = child
+		}
+		return result
+	}
+	if index == 0 {
+		return nil
+	}
+	result := n.editable(owner)
+	result.children = result.children[:index]
+	return result
+}
+
+// pushTail moves the full tail into the trie, growing the trie if it is full.
+func (v *PVector[T]) pushTail(owner *persistentOwner, tail []T) {
+	leaf := &pvectorNode[T]{values: tail, owner: owner}
+	if (v.length >> persistentBits) > (1 << v.shift) {
+		v.root = &pvectorNode[T]{children: []*pvectorNode[T]{v.root, newPVectorPath(owner, v.shift, leaf)}, owner: owner}
+		v.shift += persistentBits
+		return
+	}
+	v.root = v.pushLeaf(owner, v.shift, v.root, leaf)
+}
+
+func (v *PVector[T]) pushLeaf(owner *persistentOwner, level uint, n, leaf *pvectorNode[T]) *pvectorNode[T] {
+	index := ((v.length - 1) >> level) & persistentMask
+	result := n.editable(owner)
+	child := leaf
+	if level > persistentBits {
+		if index < len(n.children) {
+			child = v.pushLeaf(owner, level-persistentBits, n.children[index], leaf)
+		} else {
+			child = newPVectorPath(owner, level-persistentBits, leaf)
+		}
+	}
+	if index < len(result.children) {
+		result.children[index] = child
+	} else {
+		result.children = append(result.children, child)
+	}
+	return result
+}
+
+func (v *PVector[T]) set(owner *persistentOwner, index int, value T) {
+	if index < 0 || index >= v.length {
+		panic(genfuncs.NoSuchElement)
+	}
+	if index >= v.tailOffset() {
+		if owner == nil {
+			v.tail = append([]T{}, v.tail...)
+		}
+		v.tail[index&persistentMask] = value
+		return
+	}
+	v.root = v.root.set(owner, v.shift, index, value)
+}
+
+// tailOffset returns the index of the first element in the tail.
+func (v *PVector[T]) tailOffset() int {
+	if v.length < persistentWidth {
+		return 0
+	}
+	return ((v.length - 1) >> persistentBits) << persistentBits
+}
+
+// editable returns the node if owned by the owner, otherwise a copy owned by the owner.
+func (n *pvectorNode[T]) editable(owner *persistentOwner) *pvectorNode[T] {
+	if owner != nil && n.owner == owner {
+		return n
+	}
+	return &pvectorNode[T]{
+		children: append(make([]*pvectorNode[T], 0, persistentWidth), n.children...),
+		values:   append([]T{}, n.values...),
+		owner:    owner,
+	}
+}
+
+func (n *pvectorNode[T]) set(owner *persistentOwner, level uint, index int, value T) *pvectorNode[T] {
+	result := n.editable(owner)
+	if level == 0 {
+		result.values[index&persistentMask] = value
+		return result
+	}
+	i := (index >> level) & persistentMask
+	result.children[i] = result.children[i].set(owner, level-persistentBits, index, value)
+	return result
+}
+
+func newPVectorPath[T any](owner *persistentOwner, level uint, n *pvectorNode[T]) *pvectorNode[T] {
+	for ; level > 0; level -= persistentBits {
+		n = &pvectorNode[T]{children: []*pvectorNode[T]{n}, owner: owner}
+	}
+	return n
+}
+
+func (i *pvectorIterator[T]) HasNext() bool {
+	return i.index < i.vector.length
+}
+
+func (i *pvectorIterator[T]) Next() (value T) {
+	if !i.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	if i.index&persistentMask == 0 || i.values == nil {
+		i.values = i.vector.leaf(i.index)
+	}
+	value = i.values[i.index&persistentMask]
+	i.index++
+	return value
+}
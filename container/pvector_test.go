@@ -0,0 +1,125 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewPVector(t *testing.T) {
+	v := container.NewPVector[int]()
+	assert.Equal(t, 0, v.Len())
+	assert.False(t, v.Iterator().HasNext())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = v.Get(0) })
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = v.Without() })
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = v.Set(1, 1) })
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = v.Iterator().Next() })
+	v = container.NewPVector(1, 2, 3)
+	assert.Equal(t, []int{1, 2, 3}, []int(v.Values()))
+}
+
+func TestPVector_Sizes(t *testing.T) {
+	for _, size := range []int{1, 31, 32, 33, 64, 1024, 1056, 1057, 33000} {
+		want := make([]int, size)
+		v := container.NewPVector[int]()
+		for i := range want {
+			want[i] = i
+			v = v.With(i)
+		}
+		assert.Equal(t, size, v.Len())
+		assert.Equal(t, want, slices.Collect(v.All()))
+		assert.Equal(t, want, []int(container.NewPVector(want...).Values()))
+		v = v.Set(size/2, -1)
+		assert.Equal(t, -1, v.Get(size/2))
+		want[size/2] = -1
+		for i := size - 1; i >= 0; i-- {
+			assert.Equal(t, want[i], v.Get(i))
+			v = v.Without()
+		}
+		assert.Equal(t, 0, v.Len())
+	}
+}
+
+func TestPVector_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(time.Now().Unix()))
+	vectors := []*container.PVector[int]{container.NewPVector[int]()}
+	wants := [][]int{{}}
+	for i := 0; i < 5000; i++ {
+		last := vectors[len(vectors)-1]
+		want := slices.Clone(wants[len(wants)-1])
+		switch op := random.Intn(4); {
+		case op == 0 && len(want) > 0:
+			last = last.Without()
+			want = want[:len(want)-1]
+		case op == 1 && len(want) > 0:
+			index := random.Intn(len(want))
+			last = last.Set(index, -i)
+			want[index] = -i
+		default:
+			last = last.With(i)
+			want = append(want, i)
+		}
+		vectors = append(vectors, last)
+		wants = append(wants, want)
+	}
+	for i := 0; i < len(vectors); i += 89 {
+		assert.Equal(t, wants[i], append([]int{}, vectors[i].Values()...))
+	}
+}
+
+func TestPVectorBuilder(t *testing.T) {
+	v := container.NewPVector(0, 1, 2)
+	for i := 3; i < 64; i++ {
+		v = v.With(i)
+	}
+	builder := v.Transient()
+	builder.AddAll(64, 65)
+	builder.Set(0, -1)
+	builder.Set(63, -1)
+	builder.Set(65, -1)
+	assert.Equal(t, 66, builder.Len())
+	assert.Equal(t, -1, builder.Get(65))
+	built := builder.Build()
+	builder.Set(1, -1)
+	builder.RemoveLast()
+	builder.RemoveLast()
+	builder.RemoveLast()
+	builder.Set(62, -2)
+	builder.Add(99)
+	assert.Equal(t, 64, v.Len())
+	for i := 0; i < 64; i++ {
+		assert.Equal(t, i, v.Get(i))
+	}
+	want := make([]int, 66)
+	for i := range want {
+		want[i] = i
+	}
+	want[0], want[63], want[65] = -1, -1, -1
+	assert.Equal(t, want, []int(built.Values()))
+	rebuilt := builder.Build()
+	assert.Equal(t, 64, rebuilt.Len())
+	assert.Equal(t, -2, rebuilt.Get(62))
+	assert.Equal(t, 99, rebuilt.Get(63))
+	assert.Equal(t, -1, rebuilt.Get(1))
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { builder.Set(64, 1) })
+}
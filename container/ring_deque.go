@@ -0,0 +1,222 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"fmt"
+	"github.com/nwillc/genfuncs"
+	"iter"
+)
+
+var (
+	// RingDeque implements Queue.
+	_ Queue[int]    = (*RingDeque[int])(nil)
+	_ Sequence[int] = (*RingDeque[int])(nil)
+	_ Iterator[int] = (*ringDequeIterator[int])(nil)
+)
+
+const ringDequeMinCapacity = 8
+
+type (
+	// RingDeque is a doubly ended implementation of Queue with default behavior of a Fifo but provides left and right
+	// access. Employs a circular array for storage, which grows as needed, or in fixed capacity mode overwrites the
+	// oldest element when full. RingDeque implements Queue and Sequence.
+	RingDeque[T any] struct {
+		buffer []T
+		head   int
+		length int
+		fixed  bool
+	}
+	ringDequeIterator[T any] struct {
+		deque *RingDeque[T]
+		index int
+	}
+)
+
+// NewRingDeque creates a growable RingDeque containing any provided elements.
+func NewRingDeque[T any](t ...T) (deque *RingDeque[T]) {
+	deque = &RingDeque[T]{buffer: make([]T, max(ringDequeMinCapacity, len(t)))}
+	deque.AddAll(t...)
+	return deque
+}
+
+// NewFixedRingDeque creates a RingDeque of fixed capacity. When full, AddRight overwrites the left most element and
+// AddLeft overwrites the right most element, making it suited to retaining the last capacity elements added.
+func NewFixedRingDeque[T any](capacity int) (deque *RingDeque[T]) {
+	if capacity < 1 {
+		panic(fmt.Errorf("%w: deque capacity %d is less than one", genfuncs.IllegalArguments, capacity))
+	}
+	deque = &RingDeque[T]{buffer: make([]T, capacity), fixed: true}
+	return deque
+}
+
+// Add an element to the right of the RingDeque.
+func (d *RingDeque[T]) Add(t T) {
+	d.AddRight(t)
+}
+
+// AddAll elements to the right of the RingDeque.
+func (d *RingDeque[T]) AddAll(t ...T) {
+	for _, e := range t {
+		d.AddRight(e)
+	}
+}
+
+// AddLeft an element to the left of the RingDeque.
+func (d *RingDeque[T]) AddLeft(t T) {
+	if d.full() {
+		if d.fixed {
+			d.RemoveRight()
+		} else {
+			d.grow()
+		}
+	}
+	d.head = d.index(-1)
+	d.buffer[d.head] = t
+	d.length++
+}
+
+// AddRight an element to the right of the RingDeque.
+func (d *RingDeque[T]) AddRight(t T) {
+	if d.full() {
+		if d.fixed {
+			d.RemoveLeft()
+		} else {
+			d.grow()
+		}
+	}
+	d.buffer[d.index(d.length)] = t
+	d.length++
+}
+
+// All returns an iter.Seq of the elements in the RingDeque from left to right.
+func (d *RingDeque[T]) All() (seq iter.Seq[T]) {
+	seq = func(yield func(T) bool) {
+		for i := 0; i < d.length; i++ {
+			if !yield(d.buffer[d.index(i)]) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
+// Capacity returns the number of elements the RingDeque can hold before growing, or overwriting if fixed.
+func (d *RingDeque[T]) Capacity() (capacity int) {
+	capacity = len(d.buffer)
+	return capacity
+}
+
+// Get returns the element at the index from the left of the RingDeque, panicking with NoSuchElement if out of range.
+func (d *RingDeque[T]) Get(index int) (value T) {
+	if index < 0 || index >= d.length {
+		panic(genfuncs.NoSuchElement)
+	}
+	value = d.buffer[d.index(index)]
+	return value
+}
+
+// Iterator creates an Iterator over the RingDeque from left to right.
+func (d *RingDeque[T]) Iterator() Iterator[T] {
+	return &ringDequeIterator[T]{deque: d}
+}
+
+// Len reports the length of the RingDeque.
+func (d *RingDeque[T]) Len() (length int) {
+	length = d.length
+	return length
+}
+
+// Peek returns the left most element in the RingDeque without removing it.
+func (d *RingDeque[T]) Peek() (value T) {
+	value = d.PeekLeft()
+	return value
+}
+
+// PeekLeft returns the left most element in the RingDeque without removing it.
+func (d *RingDeque[T]) PeekLeft() (value T) {
+	value = d.Get(0)
+	return value
+}
+
+// PeekRight returns the right most element in the RingDeque without removing it.
+func (d *RingDeque[T]) PeekRight() (value T) {
+	value = d.Get(d.length - 1)
+	return value
+}
+
+// Remove and return the left most element in the RingDeque.
+func (d *RingDeque[T]) Remove() (value T) {
+	value = d.RemoveLeft()
+	return value
+}
+
+// RemoveLeft and return the left most element in the RingDeque.
+func (d *RingDeque[T]) RemoveLeft() (value T) {
+	value = d.PeekLeft()
+	var zero T
+	d.buffer[d.head] = zero
+	d.head = d.index(1)
+	d.length--
+	return value
+}
+
+// RemoveRight and return the right most element in the RingDeque.
+func (d *RingDeque[T]) RemoveRight() (value T) {
+	value = d.PeekRight()
+	var zero T
+	d.buffer[d.index(d.length-1)] = zero
+	d.length--
+	return value
+}
+
+// Values in the RingDeque returned in a new GSlice.
+func (d *RingDeque[T]) Values() (values GSlice[T]) {
+	values = make(GSlice[T], d.length)
+	n := copy(values, d.buffer[d.head:min(d.head+d.length, len(d.buffer))])
+	copy(values[n:], d.buffer[:d.length-n])
+	return values
+}
+
+func (d *RingDeque[T]) full() bool {
+	return d.length == len(d.buffer)
+}
+
+func (d *RingDeque[T]) grow() {
+	values := d.Values()
+	d.buffer = make([]T, len(d.buffer)*2)
+	copy(d.buffer, values)
+	d.head = 0
+}
+
+// index returns the buffer index of the offset from the head.
+func (d *RingDeque[T]) index(offset int) int {
+	return (d.head + offset + len(d.buffer)) % len(d.buffer)
+}
+
+func (i *ringDequeIterator[T]) HasNext() bool {
+	return i.index < i.deque.Len()
+}
+
+func (i *ringDequeIterator[T]) Next() (value T) {
+	if !i.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	value = i.deque.Get(i.index)
+	i.index++
+	return value
+}
@@ -0,0 +1,153 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/nwillc/genfuncs/container/sequences"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestNewRingDeque(t *testing.T) {
+	d := container.NewRingDeque[int]()
+	assert.Equal(t, 0, d.Len())
+	assert.False(t, d.Iterator().HasNext())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = d.Peek() })
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = d.PeekRight() })
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = d.Remove() })
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = d.RemoveRight() })
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = d.Iterator().Next() })
+	assert.PanicsWithError(t, genfuncs.IllegalArguments.Error()+": deque capacity 0 is less than one", func() {
+		_ = container.NewFixedRingDeque[int](0)
+	})
+}
+
+func TestRingDeque_AddRemove(t *testing.T) {
+	tests := []struct {
+		name      string
+		left      []int
+		right     []int
+		want      []int
+		wantLeft  int
+		wantRight int
+	}{
+		{
+			name:      "right",
+			right:     []int{1, 2, 3},
+			want:      []int{1, 2, 3},
+			wantLeft:  1,
+			wantRight: 3,
+		},
+		{
+			name:      "left",
+			left:      []int{1, 2, 3},
+			want:      []int{3, 2, 1},
+			wantLeft:  3,
+			wantRight: 1,
+		},
+		{
+			name:      "both and grow",
+			left:      []int{1, 2, 3, 4, 5, 6},
+			right:     []int{7, 8, 9, 10, 11, 12},
+			want:      []int{6, 5, 4, 3, 2, 1, 7, 8, 9, 10, 11, 12},
+			wantLeft:  6,
+			wantRight: 12,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := container.NewRingDeque[int]()
+			for _, v := range tt.left {
+				d.AddLeft(v)
+			}
+			d.AddAll(tt.right...)
+			assert.Equal(t, len(tt.want), d.Len())
+			assert.Equal(t, tt.want, []int(d.Values()))
+			assert.Equal(t, tt.want, slices.Collect(d.All()))
+			assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](sequences.NewSequence(tt.want...), d, genfuncs.Ordered[int]))
+			assert.Equal(t, tt.wantLeft, d.Peek())
+			assert.Equal(t, tt.wantRight, d.PeekRight())
+			assert.Equal(t, tt.wantLeft, d.Remove())
+			assert.Equal(t, tt.wantRight, d.RemoveRight())
+			assert.Equal(t, len(tt.want)-2, d.Len())
+		})
+	}
+}
+
+func TestRingDeque_Fixed(t *testing.T) {
+	d := container.NewFixedRingDeque[int](3)
+	d.AddAll(1, 2, 3, 4, 5)
+	assert.Equal(t, 3, d.Capacity())
+	assert.Equal(t, []int{3, 4, 5}, []int(d.Values()))
+	d.AddLeft(0)
+	assert.Equal(t, []int{0, 3, 4}, []int(d.Values()))
+	assert.Equal(t, 3, d.Get(1))
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = d.Get(3) })
+	assert.Equal(t, 3, d.Capacity())
+}
+
+func TestRingDeque_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(time.Now().Unix()))
+	ring := container.NewRingDeque[int]()
+	deque := container.NewDeque[int]()
+	for i := 0; i < 1000; i++ {
+		switch random.Intn(4) {
+		case 0:
+			ring.AddLeft(i)
+			deque.AddLeft(i)
+		case 1:
+			ring.AddRight(i)
+			deque.AddRight(i)
+		case 2:
+			if deque.Len() > 0 {
+				assert.Equal(t, deque.RemoveLeft(), ring.RemoveLeft())
+			}
+		case 3:
+			if deque.Len() > 0 {
+				assert.Equal(t, deque.RemoveRight(), ring.RemoveRight())
+			}
+		}
+	}
+	assert.Equal(t, deque.Values(), ring.Values())
+}
+
+func BenchmarkRingDeque(b *testing.B) {
+	d := container.NewRingDeque[int]()
+	benchmarkQueue(b, d.AddRight, d.RemoveLeft)
+}
+
+func BenchmarkDeque(b *testing.B) {
+	d := container.NewDeque[int]()
+	benchmarkQueue(b, d.AddRight, d.RemoveLeft)
+}
+
+func benchmarkQueue(b *testing.B, add func(int), remove func() int) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 1000; j++ {
+			add(j)
+		}
+		for j := 0; j < 1000; j++ {
+			_ = remove()
+		}
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"context"
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[int] = (*channelIterator[int])(nil)
+
+type channelIterator[T any] struct {
+	ctx     context.Context
+	channel <-chan T
+	next    T
+	hasData bool
+	done    bool
+}
+
+func newChannelIterator[T any](ctx context.Context, channel <-chan T) container.Iterator[T] {
+	return &channelIterator[T]{ctx: ctx, channel: channel}
+}
+
+func (c *channelIterator[T]) HasNext() bool {
+	if c.hasData {
+		return true
+	}
+	if c.done {
+		return false
+	}
+	select {
+	case c.next, c.hasData = <-c.channel:
+	case <-c.ctx.Done():
+	}
+	c.done = !c.hasData
+	return c.hasData
+}
+
+func (c *channelIterator[T]) Next() T {
+	if !c.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	c.hasData = false
+	return c.next
+}
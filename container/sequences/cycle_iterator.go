@@ -0,0 +1,54 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[int] = (*cycleIterator[int])(nil)
+
+type cycleIterator[T any] struct {
+	sequence container.Sequence[T]
+	iterator container.Iterator[T]
+	yielded  bool
+}
+
+func newCycleIterator[T any](sequence container.Sequence[T]) container.Iterator[T] {
+	return &cycleIterator[T]{sequence: sequence, iterator: sequence.Iterator()}
+}
+
+func (c *cycleIterator[T]) HasNext() bool {
+	if c.iterator.HasNext() {
+		return true
+	}
+	if !c.yielded {
+		return false
+	}
+	c.yielded = false
+	c.iterator = c.sequence.Iterator()
+	return c.iterator.HasNext()
+}
+
+func (c *cycleIterator[T]) Next() T {
+	if !c.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	c.yielded = true
+	return c.iterator.Next()
+}
@@ -0,0 +1,48 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[int] = (*dropIterator[int])(nil)
+
+type dropIterator[T any] struct {
+	iterator container.Iterator[T]
+	skip     int
+}
+
+func newDropIterator[T any](sequence container.Sequence[T], n int) container.Iterator[T] {
+	return &dropIterator[T]{iterator: sequence.Iterator(), skip: n}
+}
+
+func (d *dropIterator[T]) HasNext() bool {
+	for d.skip > 0 && d.iterator.HasNext() {
+		d.iterator.Next()
+		d.skip--
+	}
+	return d.iterator.HasNext()
+}
+
+func (d *dropIterator[T]) Next() T {
+	if !d.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	return d.iterator.Next()
+}
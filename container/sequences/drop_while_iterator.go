@@ -0,0 +1,65 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[int] = (*dropWhileIterator[int])(nil)
+
+type dropWhileIterator[T any] struct {
+	iterator  container.Iterator[T]
+	predicate genfuncs.Function[T, bool]
+	next      T
+	hasData   bool
+	dropped   bool
+}
+
+func newDropWhileIterator[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, bool]) container.Iterator[T] {
+	return &dropWhileIterator[T]{iterator: sequence.Iterator(), predicate: predicate}
+}
+
+func (d *dropWhileIterator[T]) HasNext() bool {
+	if d.hasData {
+		return true
+	}
+	if d.dropped {
+		return d.iterator.HasNext()
+	}
+	d.dropped = true
+	for d.iterator.HasNext() {
+		d.next = d.iterator.Next()
+		if !d.predicate(d.next) {
+			d.hasData = true
+			return true
+		}
+	}
+	return false
+}
+
+func (d *dropWhileIterator[T]) Next() T {
+	if !d.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	if d.hasData {
+		d.hasData = false
+		return d.next
+	}
+	return d.iterator.Next()
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/nwillc/genfuncs/container/maps"
 	"github.com/nwillc/genfuncs/container/sequences"
 	"github.com/nwillc/genfuncs/internal/tests"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -31,7 +32,13 @@ func TestFunctionExamples(t *testing.T) {
 	tests.MaybeRunExamples(t)
 	ExampleAssociate()
 	ExampleAssociateWith()
+	ExampleChunked()
+	ExampleFilter()
 	ExampleFold()
+	ExampleIterate()
+	ExampleMaxBy()
+	ExampleTakeWhile()
+	ExampleToSeq()
 }
 
 func ExampleAssociate() {
@@ -65,6 +72,24 @@ func ExampleAssociateWith() {
 	// ODD
 }
 
+func ExampleChunked() {
+	batches := sequences.Chunked(sequences.NewSequence(1, 2, 3, 4, 5), 2)
+	sequences.ForEach(batches, func(batch container.GSlice[int]) {
+		fmt.Println(batch)
+	})
+	// Output:
+	// [1 2]
+	// [3 4]
+	// [5]
+}
+
+func ExampleFilter() {
+	sequence := sequences.NewSequence(1, -2, 3, -4)
+	positives := sequences.Filter(sequence, genfuncs.OrderedGreaterThan(0))
+	fmt.Println(sequences.JoinToString(positives, strconv.Itoa, ", ", "[", "]"))
+	// Output: [1, 3]
+}
+
 func ExampleFold() {
 	sequence := sequences.NewSequence(1, 2, 3, 4)
 	sum := sequences.Fold(sequence, 0, func(prior, value int) int {
@@ -73,3 +98,33 @@ func ExampleFold() {
 	fmt.Println(sum)
 	// Output: 10
 }
+
+func ExampleIterate() {
+	powersOfTwo := sequences.Iterate(1, func(i int) int { return i * 2 })
+	fmt.Println(sequences.JoinToString(sequences.Take(powersOfTwo, 5), strconv.Itoa, ", ", "[", "]"))
+	// Output: [1, 2, 4, 8, 16]
+}
+
+func ExampleMaxBy() {
+	words := sequences.NewSequence("a", "quick", "fox")
+	sequences.MaxBy(words, func(s string) int { return len(s) }).
+		OnSuccess(func(longest string) { fmt.Println(longest) })
+	// Output: quick
+}
+
+func ExampleTakeWhile() {
+	sequence := sequences.NewSequence(2, 4, 5, 6)
+	evens := sequences.TakeWhile(sequence, func(i int) bool { return i%2 == 0 })
+	fmt.Println(sequences.JoinToString(evens, strconv.Itoa, ", ", "[", "]"))
+	// Output: [2, 4]
+}
+
+func ExampleToSeq() {
+	for i := range sequences.ToSeq(sequences.Range(0, 3, 1)) {
+		fmt.Println(i)
+	}
+	// Output:
+	// 0
+	// 1
+	// 2
+}
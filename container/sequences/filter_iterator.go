@@ -0,0 +1,57 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[int] = (*filterIterator[int])(nil)
+
+type filterIterator[T any] struct {
+	iterator  container.Iterator[T]
+	predicate genfuncs.Function[T, bool]
+	next      T
+	hasData   bool
+}
+
+func newFilterIterator[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, bool]) container.Iterator[T] {
+	return &filterIterator[T]{iterator: sequence.Iterator(), predicate: predicate}
+}
+
+func (f *filterIterator[T]) HasNext() bool {
+	if f.hasData {
+		return true
+	}
+	for f.iterator.HasNext() {
+		f.next = f.iterator.Next()
+		if f.predicate(f.next) {
+			f.hasData = true
+			return true
+		}
+	}
+	return false
+}
+
+func (f *filterIterator[T]) Next() T {
+	if !f.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	f.hasData = false
+	return f.next
+}
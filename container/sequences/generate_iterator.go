@@ -0,0 +1,57 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[int] = (*generateIterator[int])(nil)
+
+type generateIterator[T any] struct {
+	generator func() *genfuncs.Result[T]
+	next      *genfuncs.Result[T]
+	done      bool
+}
+
+func newGenerateIterator[T any](generator func() *genfuncs.Result[T]) container.Iterator[T] {
+	return &generateIterator[T]{generator: generator}
+}
+
+func (g *generateIterator[T]) HasNext() bool {
+	if g.done {
+		return false
+	}
+	if g.next == nil {
+		g.next = g.generator()
+	}
+	if !g.next.Ok() {
+		g.done = true
+		return false
+	}
+	return true
+}
+
+func (g *generateIterator[T]) Next() T {
+	if !g.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	value := g.next.OrEmpty()
+	g.next = nil
+	return value
+}
@@ -0,0 +1,46 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[int] = (*iterateIterator[int])(nil)
+
+type iterateIterator[T any] struct {
+	current T
+	next    genfuncs.Function[T, T]
+	started bool
+}
+
+func newIterateIterator[T any](seed T, next genfuncs.Function[T, T]) container.Iterator[T] {
+	return &iterateIterator[T]{current: seed, next: next}
+}
+
+func (i *iterateIterator[T]) HasNext() bool {
+	return true
+}
+
+func (i *iterateIterator[T]) Next() T {
+	if i.started {
+		i.current = i.next(i.current)
+	}
+	i.started = true
+	return i.current
+}
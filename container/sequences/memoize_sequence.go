@@ -0,0 +1,72 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var (
+	_ container.Sequence[int] = (*memoizeSequence[int])(nil)
+	_ container.Iterator[int] = (*memoizeIterator[int])(nil)
+)
+
+type (
+	// memoizeSequence iterates its source at most once, caching the values as they are retrieved so that its own
+	// Iterators can revisit them.
+	memoizeSequence[T any] struct {
+		source   container.Sequence[T]
+		iterator container.Iterator[T]
+		cache    container.GSlice[T]
+	}
+	memoizeIterator[T any] struct {
+		memo  *memoizeSequence[T]
+		index int
+	}
+)
+
+func (m *memoizeSequence[T]) Iterator() container.Iterator[T] {
+	return &memoizeIterator[T]{memo: m}
+}
+
+func (m *memoizeSequence[T]) fetch(index int) bool {
+	if index < len(m.cache) {
+		return true
+	}
+	if m.iterator == nil {
+		m.iterator = m.source.Iterator()
+	}
+	if !m.iterator.HasNext() {
+		return false
+	}
+	m.cache = append(m.cache, m.iterator.Next())
+	return true
+}
+
+func (m *memoizeIterator[T]) HasNext() bool {
+	return m.memo.fetch(m.index)
+}
+
+func (m *memoizeIterator[T]) Next() T {
+	if !m.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	value := m.memo.cache[m.index]
+	m.index++
+	return value
+}
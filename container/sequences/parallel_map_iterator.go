@@ -0,0 +1,205 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"context"
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"runtime"
+	"sync"
+)
+
+var (
+	_ container.Iterator[int]                   = (*parallelMapIterator[string, int])(nil)
+	_ container.Iterator[*genfuncs.Result[int]] = (*parallelMapResultIterator[string, int])(nil)
+)
+
+type (
+	// parallelMapIterator consumes the results of a feeder and workers started on the first HasNext. The goroutines
+	// only reference a derived context and channels, never the iterator, so an iterator abandoned before it is
+	// exhausted becomes unreachable and a cleanup cancels the context, releasing them.
+	parallelMapIterator[T, R any] struct {
+		ctx       context.Context
+		cancel    context.CancelFunc
+		sequence  container.Sequence[T]
+		workers   int
+		transform genfuncs.Function[T, R]
+		futures   chan chan R
+		running   *sync.WaitGroup
+		started   bool
+		next      R
+		hasData   bool
+		done      bool
+		err       error
+	}
+	parallelMapJob[T, R any] struct {
+		value  T
+		future chan R
+	}
+	parallelMapResultIterator[T, R any] struct {
+		iterator *parallelMapIterator[T, *genfuncs.Result[R]]
+		pending  *genfuncs.Result[R]
+		done     bool
+	}
+)
+
+func newParallelMapIterator[T, R any](
+	ctx context.Context,
+	sequence container.Sequence[T],
+	workers int,
+	transform genfuncs.Function[T, R],
+) *parallelMapIterator[T, R] {
+	ctx, cancel := context.WithCancel(ctx)
+	p := &parallelMapIterator[T, R]{
+		ctx:       ctx,
+		cancel:    cancel,
+		sequence:  sequence,
+		workers:   workers,
+		transform: transform,
+		running:   new(sync.WaitGroup),
+	}
+	runtime.AddCleanup(p, func(cancel context.CancelFunc) { cancel() }, cancel)
+	return p
+}
+
+func (p *parallelMapIterator[T, R]) HasNext() bool {
+	if p.hasData {
+		return true
+	}
+	if p.done {
+		return false
+	}
+	if !p.started {
+		p.start()
+	}
+	select {
+	case future, ok := <-p.futures:
+		if !ok {
+			p.stop(nil)
+			return false
+		}
+		select {
+		case p.next = <-future:
+			p.hasData = true
+			return true
+		case <-p.ctx.Done():
+		}
+	case <-p.ctx.Done():
+	}
+	p.stop(p.ctx.Err())
+	return false
+}
+
+func (p *parallelMapIterator[T, R]) Next() R {
+	if !p.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	p.hasData = false
+	return p.next
+}
+
+// start the feeder and the workers. The feeder reads the source and hands each value to the workers along with a
+// future, queueing the futures in source order. The futures channel capacity bounds the work in flight.
+func (p *parallelMapIterator[T, R]) start() {
+	p.started = true
+	p.futures = make(chan chan R, p.workers)
+	ctx, futures, sequence, transform, running := p.ctx, p.futures, p.sequence, p.transform, p.running
+	jobs := make(chan parallelMapJob[T, R])
+	running.Add(p.workers + 1)
+	go func() {
+		defer running.Done()
+		defer close(futures)
+		defer close(jobs)
+		iterator := sequence.Iterator()
+		for iterator.HasNext() {
+			job := parallelMapJob[T, R]{value: iterator.Next(), future: make(chan R, 1)}
+			select {
+			case futures <- job.future:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	for i := 0; i < p.workers; i++ {
+		go func() {
+			defer running.Done()
+			for job := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				job.future <- transform(job.value)
+			}
+		}()
+	}
+}
+
+// stop ends the iteration, recording any error, and cancels the context releasing the goroutines.
+func (p *parallelMapIterator[T, R]) stop(err error) {
+	p.done = true
+	p.err = err
+	p.cancel()
+}
+
+func newParallelMapResultIterator[T, R any](
+	ctx context.Context,
+	sequence container.Sequence[T],
+	workers int,
+	transform genfuncs.Function[T, *genfuncs.Result[R]],
+) container.Iterator[*genfuncs.Result[R]] {
+	return &parallelMapResultIterator[T, R]{iterator: newParallelMapIterator(ctx, sequence, workers, transform)}
+}
+
+func (p *parallelMapResultIterator[T, R]) HasNext() bool {
+	if p.pending != nil {
+		return true
+	}
+	if p.done {
+		return false
+	}
+	if p.iterator.HasNext() {
+		return true
+	}
+	p.done = true
+	if p.iterator.err != nil {
+		p.pending = genfuncs.NewError[R](p.iterator.err)
+		return true
+	}
+	return false
+}
+
+func (p *parallelMapResultIterator[T, R]) Next() (result *genfuncs.Result[R]) {
+	if !p.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	if p.pending != nil {
+		result = p.pending
+		p.pending = nil
+		return result
+	}
+	result = p.iterator.Next()
+	if !result.Ok() {
+		p.done = true
+		p.iterator.cancel()
+	}
+	return result
+}
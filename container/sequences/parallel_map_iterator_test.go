@@ -0,0 +1,95 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"context"
+	"github.com/nwillc/genfuncs"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func identity(i int) int { return i }
+
+func Test_parallelMapIterator_ReleasedWhenExhausted(t *testing.T) {
+	iterator := newParallelMapIterator(context.Background(), NewSequence(1, 2, 3), 2, identity)
+	count := 0
+	for iterator.HasNext() {
+		_ = iterator.Next()
+		count++
+	}
+	assert.Equal(t, 3, count)
+	assert.NoError(t, iterator.err)
+	iterator.running.Wait()
+}
+
+func Test_parallelMapIterator_ReleasedWhenErrorResult(t *testing.T) {
+	iterator := newParallelMapResultIterator(context.Background(), Range(0, 1_000_000, 1), 4,
+		func(i int) *genfuncs.Result[int] {
+			if i == 2 {
+				return genfuncs.NewError[int](genfuncs.IllegalArguments)
+			}
+			return genfuncs.NewResult(i)
+		}).(*parallelMapResultIterator[int, int])
+	for iterator.HasNext() {
+		_ = iterator.Next()
+	}
+	iterator.iterator.running.Wait()
+}
+
+func Test_parallelMapIterator_ReleasedWhenAbandoned(t *testing.T) {
+	tests := []struct {
+		name    string
+		abandon func() (context.Context, func())
+	}{
+		{
+			name: "ParallelMap",
+			abandon: func() (context.Context, func()) {
+				iterator := newParallelMapIterator(context.Background(), Range(0, 1_000_000, 1), 4, identity)
+				assert.Equal(t, 0, iterator.Next())
+				return iterator.ctx, iterator.running.Wait
+			},
+		},
+		{
+			name: "ParallelMapResult",
+			abandon: func() (context.Context, func()) {
+				iterator := newParallelMapResultIterator(context.Background(), Range(0, 1_000_000, 1), 4,
+					genfuncs.NewResult[int]).(*parallelMapResultIterator[int, int])
+				assert.Equal(t, 0, iterator.Next().MustGet())
+				return iterator.iterator.ctx, iterator.iterator.running.Wait
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, wait := tt.abandon()
+			deadline := time.After(5 * time.Second)
+			for ctx.Err() == nil {
+				runtime.GC()
+				select {
+				case <-ctx.Done():
+				case <-deadline:
+					t.Fatal("abandoned iterator was not cleaned up")
+				case <-time.After(time.Millisecond):
+				}
+			}
+			wait()
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"golang.org/x/exp/constraints"
+)
+
+var _ container.Iterator[int] = (*rangeIterator[int])(nil)
+
+type rangeIterator[T constraints.Integer] struct {
+	current T
+	end     T
+	step    T
+	done    bool
+}
+
+func newRangeIterator[T constraints.Integer](start, end, step T) container.Iterator[T] {
+	return &rangeIterator[T]{current: start, end: end, step: step}
+}
+
+func (r *rangeIterator[T]) HasNext() bool {
+	if r.done {
+		return false
+	}
+	if r.step > 0 {
+		return r.current < r.end
+	}
+	return r.current > r.end
+}
+
+func (r *rangeIterator[T]) Next() T {
+	if !r.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	value := r.current
+	r.current += r.step
+	// Stepping past the bounds of T wraps around, which would restart the range.
+	if (r.step > 0 && r.current < value) || (r.step < 0 && r.current > value) {
+		r.done = true
+	}
+	return value
+}
@@ -0,0 +1,61 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"iter"
+)
+
+var _ container.Iterator[int] = (*seqIterator[int])(nil)
+
+type seqIterator[T any] struct {
+	next    func() (T, bool)
+	stop    func()
+	value   T
+	hasData bool
+	done    bool
+}
+
+func newSeqIterator[T any](seq iter.Seq[T]) container.Iterator[T] {
+	next, stop := iter.Pull(seq)
+	return &seqIterator[T]{next: next, stop: stop}
+}
+
+func (s *seqIterator[T]) HasNext() bool {
+	if s.hasData {
+		return true
+	}
+	if s.done {
+		return false
+	}
+	s.value, s.hasData = s.next()
+	if !s.hasData {
+		s.done = true
+		s.stop()
+	}
+	return s.hasData
+}
+
+func (s *seqIterator[T]) Next() T {
+	if !s.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	s.hasData = false
+	return s.value
+}
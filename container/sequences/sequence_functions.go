@@ -17,10 +17,15 @@
 package sequences
 
 import (
+	"context"
+	"fmt"
 	"github.com/nwillc/genfuncs"
 	"github.com/nwillc/genfuncs/container"
 	"github.com/nwillc/genfuncs/container/maps"
 	"github.com/nwillc/genfuncs/results"
+	"golang.org/x/exp/constraints"
+	"iter"
+	"math"
 	"strings"
 )
 
@@ -51,16 +56,19 @@ func Any[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, b
 }
 
 // Associate returns a map containing key/values created by applying a function to each value of the container.Iterator
-// returned by the container.Sequence.
+// returned by the container.Sequence. If the function returns an error Result, that error is returned.
 func Associate[T any, K comparable, V any](sequence container.Sequence[T], keyValueFor maps.KeyValueFor[T, K, V]) (result *genfuncs.Result[container.GMap[K, V]]) {
 	iterator := sequence.Iterator()
 	m := make(container.GMap[K, V])
+	var entry *genfuncs.Result[*maps.Entry[K, V]]
+	var kv *maps.Entry[K, V]
 	for iterator.HasNext() {
-		keyValueFor(iterator.Next()).
-			OnSuccess(func(kv *maps.Entry[K, V]) {
-				m[kv.Key] = kv.Value
-			})
-
+		entry = keyValueFor(iterator.Next())
+		if !entry.Ok() {
+			return results.MapError[*maps.Entry[K, V], container.GMap[K, V]](entry)
+		}
+		kv = entry.OrEmpty()
+		m[kv.Key] = kv.Value
 	}
 	return genfuncs.NewResult(m)
 }
@@ -82,6 +90,30 @@ func AssociateWith[K comparable, V any](sequence container.Sequence[K], valueFor
 	return genfuncs.NewResult(m)
 }
 
+// Average returns the average of the values in the Sequence, or NaN if the Sequence is empty.
+func Average[T genfuncs.Number](sequence container.Sequence[T]) (average float64) {
+	iterator := sequence.Iterator()
+	var sum float64
+	count := 0
+	for iterator.HasNext() {
+		sum += float64(iterator.Next())
+		count++
+	}
+	if count == 0 {
+		average = math.NaN()
+		return average
+	}
+	average = sum / float64(count)
+	return average
+}
+
+// Chunked splits the Sequence into a Sequence of GSlice's each not exceeding the given size. The last GSlice
+// may have fewer elements.
+func Chunked[T any](sequence container.Sequence[T], size int) (result container.Sequence[container.GSlice[T]]) {
+	result = Windowed(sequence, size, size, true)
+	return result
+}
+
 // Collect elements from a Sequence into a Container.
 func Collect[T any](s container.Sequence[T], c container.Container[T]) {
 	iterator := s.Iterator()
@@ -90,6 +122,22 @@ func Collect[T any](s container.Sequence[T], c container.Container[T]) {
 	}
 }
 
+// CollectResults collects the values of a Sequence of Result's into a GSlice. If an error Result is encountered
+// collection stops and that error is returned.
+func CollectResults[T any](sequence container.Sequence[*genfuncs.Result[T]]) (result *genfuncs.Result[container.GSlice[T]]) {
+	iterator := sequence.Iterator()
+	values := container.GSlice[T]{}
+	var r *genfuncs.Result[T]
+	for iterator.HasNext() {
+		r = iterator.Next()
+		if !r.Ok() {
+			return results.MapError[T, container.GSlice[T]](r)
+		}
+		values = append(values, r.OrEmpty())
+	}
+	return genfuncs.NewResult(values)
+}
+
 // Compare two sequences with a comparator returning less/equal/greater (-1/0/1) and return comparison of the two.
 func Compare[T any](s1, s2 container.Sequence[T], comparator func(t1, t2 T) int) int {
 	i1 := s1.Iterator()
@@ -109,6 +157,26 @@ func Compare[T any](s1, s2 container.Sequence[T], comparator func(t1, t2 T) int)
 	return genfuncs.EqualTo
 }
 
+// Count returns the number of elements in the Sequence matching the predicate.
+func Count[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, bool]) (count int) {
+	iterator := sequence.Iterator()
+	for iterator.HasNext() {
+		if predicate(iterator.Next()) {
+			count++
+		}
+	}
+	return count
+}
+
+// Cycle returns a Sequence that repeats the elements of the given Sequence indefinitely. The given Sequence is
+// iterated once, with its values memoized on the first pass and repeated from there, so single use Sequences can be
+// cycled. If the given Sequence is empty so is the result.
+func Cycle[T any](sequence container.Sequence[T]) (result container.Sequence[T]) {
+	sequence = Memoize(sequence)
+	result = sequenceFunc[T](func() container.Iterator[T] { return newCycleIterator(sequence) })
+	return result
+}
+
 // Distinct collects a sequence into a container.Set and returns it as a Sequence.
 func Distinct[T comparable](s container.Sequence[T]) container.Sequence[T] {
 	set := container.NewMapSet[T]()
@@ -116,6 +184,37 @@ func Distinct[T comparable](s container.Sequence[T]) container.Sequence[T] {
 	return set
 }
 
+// Drop returns a Sequence containing all elements except the first n.
+func Drop[T any](sequence container.Sequence[T], n int) (result container.Sequence[T]) {
+	requireNonNegative(n)
+	result = sequenceFunc[T](func() container.Iterator[T] { return newDropIterator(sequence, n) })
+	return result
+}
+
+// DropWhile returns a Sequence containing all elements except the first elements that satisfy the predicate.
+func DropWhile[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, bool]) (result container.Sequence[T]) {
+	result = sequenceFunc[T](func() container.Iterator[T] { return newDropWhileIterator(sequence, predicate) })
+	return result
+}
+
+// Filter returns a Sequence containing only the elements matching the predicate.
+func Filter[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, bool]) (result container.Sequence[T]) {
+	result = sequenceFunc[T](func() container.Iterator[T] { return newFilterIterator(sequence, predicate) })
+	return result
+}
+
+// FilterNot returns a Sequence containing only the elements not matching the predicate.
+func FilterNot[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, bool]) (result container.Sequence[T]) {
+	result = Filter(sequence, genfuncs.Not(predicate))
+	return result
+}
+
+// FilterOk returns a Sequence of the values of the Ok Result's in a Sequence of Result's, skipping any errors.
+func FilterOk[T any](sequence container.Sequence[*genfuncs.Result[T]]) (result container.Sequence[T]) {
+	result = Map(Filter(sequence, (*genfuncs.Result[T]).Ok), (*genfuncs.Result[T]).OrEmpty)
+	return result
+}
+
 // Find returns the first element matching the given predicate, or Result error of NoSuchElement if not found.
 func Find[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, bool]) *genfuncs.Result[T] {
 	iterator := sequence.Iterator()
@@ -146,7 +245,7 @@ func FindLast[T any](sequence container.Sequence[T], predicate genfuncs.Function
 // FlatMap returns a sequence of all elements from results of valueFor being invoked on each element of
 // original sequence, and those resultant slices concatenated.
 func FlatMap[T, R any](sequence container.Sequence[T], transform genfuncs.Function[T, container.Sequence[R]]) (result container.Sequence[R]) {
-	return container.NewIteratorSequence(newFlatMapIterator(sequence, transform))
+	return sequenceFunc[R](func() container.Iterator[R] { return newFlatMapIterator(sequence, transform) })
 }
 
 // Fold accumulates a value starting with an initial value and applying operation to each value of the container.Iterator
@@ -168,6 +267,27 @@ func ForEach[T any](sequence container.Sequence[T], action func(t T)) {
 	}
 }
 
+// FromChannel returns a Sequence of the values received from a channel. The Sequence ends when the channel is closed
+// or the context is cancelled. A channel can only be consumed once, and so can the Sequence.
+func FromChannel[T any](ctx context.Context, channel <-chan T) (result container.Sequence[T]) {
+	result = container.NewIteratorSequence(newChannelIterator(ctx, channel))
+	return result
+}
+
+// FromSeq returns a Sequence of the values of an iter.Seq. Each Iterator of the Sequence pulls from the iter.Seq anew,
+// and holds the resources of iter.Pull until it is exhausted.
+func FromSeq[T any](seq iter.Seq[T]) (result container.Sequence[T]) {
+	result = sequenceFunc[T](func() container.Iterator[T] { return newSeqIterator(seq) })
+	return result
+}
+
+// Generate returns a Sequence of the values produced by successive calls to the generator. The Sequence ends when the
+// generator returns an error Result.
+func Generate[T any](generator func() *genfuncs.Result[T]) (result container.Sequence[T]) {
+	result = sequenceFunc[T](func() container.Iterator[T] { return newGenerateIterator(generator) })
+	return result
+}
+
 // IsSorted returns true if the GSlice is sorted by order.
 func IsSorted[T any](sequence container.Sequence[T], order genfuncs.BiFunction[T, T, bool]) (ok bool) {
 	iterator := sequence.Iterator()
@@ -189,6 +309,13 @@ func IsSorted[T any](sequence container.Sequence[T], order genfuncs.BiFunction[T
 	return ok
 }
 
+// Iterate returns an infinite Sequence starting with the seed and followed by the successive application of next to
+// the prior value.
+func Iterate[T any](seed T, next genfuncs.Function[T, T]) (result container.Sequence[T]) {
+	result = sequenceFunc[T](func() container.Iterator[T] { return newIterateIterator(seed, next) })
+	return result
+}
+
 // JoinToString creates a string from all the elements of a Sequence using the stringer on each, separating them using separator, and
 // using the given prefix and postfix.
 func JoinToString[T any](
@@ -216,7 +343,37 @@ func JoinToString[T any](
 
 // Map elements in a Sequence to a new Sequence having applied the valueFor to them.
 func Map[T, R any](sequence container.Sequence[T], transform genfuncs.Function[T, R]) container.Sequence[R] {
-	return container.NewIteratorSequence[R](transformIterator[T, R]{iterator: sequence.Iterator(), transform: transform})
+	return sequenceFunc[R](func() container.Iterator[R] {
+		return transformIterator[T, R]{iterator: sequence.Iterator(), transform: transform}
+	})
+}
+
+// Memoize returns a re-iterable Sequence of the values of the given Sequence. The given Sequence is iterated at most
+// once, lazily, with the values cached as they are retrieved. The resulting Sequence is not goroutine safe.
+func Memoize[T any](sequence container.Sequence[T]) (result container.Sequence[T]) {
+	result = &memoizeSequence[T]{source: sequence}
+	return result
+}
+
+// MaxBy returns the first element yielding the largest key from the keyFor function, or Result error of NoSuchElement
+// if the Sequence is empty.
+func MaxBy[T any, K constraints.Ordered](sequence container.Sequence[T], keyFor genfuncs.Function[T, K]) (result *genfuncs.Result[T]) {
+	result = extremeBy(sequence, keyFor, genfuncs.OrderedGreater[K])
+	return result
+}
+
+// MinBy returns the first element yielding the smallest key from the keyFor function, or Result error of
+// NoSuchElement if the Sequence is empty.
+func MinBy[T any, K constraints.Ordered](sequence container.Sequence[T], keyFor genfuncs.Function[T, K]) (result *genfuncs.Result[T]) {
+	result = extremeBy(sequence, keyFor, genfuncs.OrderedLess[K])
+	return result
+}
+
+// MapResult elements in a Sequence of Result's to a new Sequence of Result's having applied the transform to the
+// values of the Ok Result's. Error Result's are passed through.
+func MapResult[T, R any](sequence container.Sequence[*genfuncs.Result[T]], transform genfuncs.Function[T, *genfuncs.Result[R]]) (result container.Sequence[*genfuncs.Result[R]]) {
+	result = Map(sequence, func(r *genfuncs.Result[T]) *genfuncs.Result[R] { return results.Map(r, transform) })
+	return result
 }
 
 // NewSequence creates a sequence from the provided values.
@@ -224,3 +381,196 @@ func NewSequence[T any](values ...T) (sequence container.Sequence[T]) {
 	var slice container.GSlice[T] = values
 	return slice
 }
+
+// Once returns a Sequence that can only be iterated once. Requesting a second Iterator panics with
+// genfuncs.IllegalState.
+func Once[T any](sequence container.Sequence[T]) (result container.Sequence[T]) {
+	result = &onceSequence[T]{sequence: sequence}
+	return result
+}
+
+// ParallelMap elements in a Sequence to a new Sequence having applied the transform to them concurrently using the
+// given number of worker goroutines. The order of the Sequence is preserved. The resulting Sequence ends early if the
+// context is cancelled. Each Iterator starts its own goroutines, which exit when the Iterator is exhausted, the
+// context is cancelled, or the Iterator is abandoned and garbage collected.
+func ParallelMap[T, R any](
+	ctx context.Context,
+	sequence container.Sequence[T],
+	workers int,
+	transform genfuncs.Function[T, R],
+) (result container.Sequence[R]) {
+	requirePositive(workers)
+	result = sequenceFunc[R](func() container.Iterator[R] {
+		return newParallelMapIterator(ctx, sequence, workers, transform)
+	})
+	return result
+}
+
+// ParallelMapResult is a ParallelMap for a transform returning a genfuncs.Result. The resulting Sequence ends with
+// the first error Result encountered, in order, cancelling any remaining work. If the context is cancelled the
+// Sequence ends with an error Result of the context's error. Its goroutines are released as with ParallelMap.
+func ParallelMapResult[T, R any](
+	ctx context.Context,
+	sequence container.Sequence[T],
+	workers int,
+	transform genfuncs.Function[T, *genfuncs.Result[R]],
+) (result container.Sequence[*genfuncs.Result[R]]) {
+	requirePositive(workers)
+	result = sequenceFunc[*genfuncs.Result[R]](func() container.Iterator[*genfuncs.Result[R]] {
+		return newParallelMapResultIterator(ctx, sequence, workers, transform)
+	})
+	return result
+}
+
+// Partition splits the Sequence into a GSlice of the elements matching the predicate and a GSlice of those that do
+// not.
+func Partition[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, bool]) (matching, notMatching container.GSlice[T]) {
+	iterator := sequence.Iterator()
+	matching = container.GSlice[T]{}
+	notMatching = container.GSlice[T]{}
+	var t T
+	for iterator.HasNext() {
+		t = iterator.Next()
+		if predicate(t) {
+			matching = append(matching, t)
+		} else {
+			notMatching = append(notMatching, t)
+		}
+	}
+	return matching, notMatching
+}
+
+// Range returns a Sequence of integers from start up to, but not including, end, incrementing by step. A negative
+// step produces a descending Sequence.
+func Range[T constraints.Integer](start, end, step T) (result container.Sequence[T]) {
+	if step == 0 {
+		panic(fmt.Errorf("%w: step must not be zero", genfuncs.IllegalArguments))
+	}
+	result = sequenceFunc[T](func() container.Iterator[T] { return newRangeIterator(start, end, step) })
+	return result
+}
+
+// Repeat returns a Sequence containing the value repeated n times.
+func Repeat[T any](value T, n int) (result container.Sequence[T]) {
+	requireNonNegative(n)
+	result = sequenceFunc[T](func() container.Iterator[T] { return newRepeatIterator(value, n) })
+	return result
+}
+
+// Sum returns the sum of the values in the Sequence.
+func Sum[T genfuncs.Number](sequence container.Sequence[T]) (sum T) {
+	iterator := sequence.Iterator()
+	for iterator.HasNext() {
+		sum += iterator.Next()
+	}
+	return sum
+}
+
+// Take returns a Sequence containing at most the first n elements.
+func Take[T any](sequence container.Sequence[T], n int) (result container.Sequence[T]) {
+	requireNonNegative(n)
+	result = sequenceFunc[T](func() container.Iterator[T] { return newTakeIterator(sequence, n) })
+	return result
+}
+
+// TakeWhile returns a Sequence containing the first elements satisfying the predicate.
+func TakeWhile[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, bool]) (result container.Sequence[T]) {
+	result = sequenceFunc[T](func() container.Iterator[T] { return newTakeWhileIterator(sequence, predicate) })
+	return result
+}
+
+// ToChannel returns a channel, with the given buffer size, that the values of the Sequence are sent to from a new
+// goroutine. The channel is closed when the Sequence is exhausted or the context is cancelled.
+func ToChannel[T any](ctx context.Context, sequence container.Sequence[T], buffer int) (channel <-chan T) {
+	requireNonNegative(buffer)
+	c := make(chan T, buffer)
+	go func() {
+		defer close(c)
+		iterator := sequence.Iterator()
+		for iterator.HasNext() {
+			select {
+			case c <- iterator.Next():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	channel = c
+	return channel
+}
+
+// ToSeq returns an iter.Seq of the values of a Sequence, allowing it to be used with range and the iter package.
+func ToSeq[T any](sequence container.Sequence[T]) (seq iter.Seq[T]) {
+	seq = func(yield func(T) bool) {
+		iterator := sequence.Iterator()
+		for iterator.HasNext() {
+			if !yield(iterator.Next()) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
+// Windowed returns a Sequence of snapshots, GSlice's, of the window of the given size sliding along the Sequence
+// with the given step. If partial is true, windows smaller than size at the end of the Sequence are included.
+func Windowed[T any](sequence container.Sequence[T], size, step int, partial bool) (result container.Sequence[container.GSlice[T]]) {
+	requirePositive(size)
+	requirePositive(step)
+	result = sequenceFunc[container.GSlice[T]](func() container.Iterator[container.GSlice[T]] {
+		return newWindowedIterator(sequence, size, step, partial)
+	})
+	return result
+}
+
+// Zip returns a Sequence of Pair's built from the elements of the two Sequences with the same index. The resulting
+// Sequence has the length of the shortest Sequence.
+func Zip[A, B any](first container.Sequence[A], second container.Sequence[B]) (result container.Sequence[genfuncs.Pair[A, B]]) {
+	result = sequenceFunc[genfuncs.Pair[A, B]](func() container.Iterator[genfuncs.Pair[A, B]] {
+		return newZipIterator(first, second)
+	})
+	return result
+}
+
+// ZipWithNext returns a Sequence of Pair's of each element and the one following it.
+func ZipWithNext[T any](sequence container.Sequence[T]) (result container.Sequence[genfuncs.Pair[T, T]]) {
+	result = sequenceFunc[genfuncs.Pair[T, T]](func() container.Iterator[genfuncs.Pair[T, T]] {
+		return newZipWithNextIterator(sequence)
+	})
+	return result
+}
+
+func extremeBy[T any, K constraints.Ordered](
+	sequence container.Sequence[T],
+	keyFor genfuncs.Function[T, K],
+	better genfuncs.BiFunction[K, K, bool],
+) *genfuncs.Result[T] {
+	iterator := sequence.Iterator()
+	if !iterator.HasNext() {
+		return genfuncs.NewError[T](genfuncs.NoSuchElement)
+	}
+	extreme := iterator.Next()
+	extremeKey := keyFor(extreme)
+	var t T
+	var k K
+	for iterator.HasNext() {
+		t = iterator.Next()
+		k = keyFor(t)
+		if better(k, extremeKey) {
+			extreme, extremeKey = t, k
+		}
+	}
+	return genfuncs.NewResult(extreme)
+}
+
+func requireNonNegative(n int) {
+	if n < 0 {
+		panic(fmt.Errorf("%w: count %d is less than zero", genfuncs.IllegalArguments, n))
+	}
+}
+
+func requirePositive(n int) {
+	if n < 1 {
+		panic(fmt.Errorf("%w: count %d is less than one", genfuncs.IllegalArguments, n))
+	}
+}
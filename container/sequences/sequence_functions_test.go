@@ -17,14 +17,20 @@
 package sequences_test
 
 import (
+	"context"
 	"fmt"
 	"github.com/nwillc/genfuncs"
 	"github.com/nwillc/genfuncs/container"
 	"github.com/nwillc/genfuncs/container/maps"
 	"github.com/nwillc/genfuncs/container/sequences"
 	"github.com/stretchr/testify/assert"
+	"math"
+	"math/rand"
+	"slices"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 type PersonName struct {
@@ -773,3 +779,926 @@ func TestDistinct(t *testing.T) {
 		})
 	}
 }
+
+func TestFilter(t *testing.T) {
+	type args struct {
+		sequence  container.Sequence[int]
+		predicate genfuncs.Function[int, bool]
+	}
+	tests := []struct {
+		name string
+		args args
+		want container.GSlice[int]
+	}{
+		{
+			name: "Empty",
+			args: args{
+				sequence:  sequences.NewSequence[int](),
+				predicate: genfuncs.OrderedGreaterThan(0),
+			},
+			want: container.GSlice[int]{},
+		},
+		{
+			name: "None Match",
+			args: args{
+				sequence:  sequences.NewSequence(-1, -2),
+				predicate: genfuncs.OrderedGreaterThan(0),
+			},
+			want: container.GSlice[int]{},
+		},
+		{
+			name: "Some Match",
+			args: args{
+				sequence:  container.NewList(1, -2, 3, -4, 5),
+				predicate: genfuncs.OrderedGreaterThan(0),
+			},
+			want: container.GSlice[int]{1, 3, 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.Filter(tt.args.sequence, tt.args.predicate)
+			assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](got, tt.want, genfuncs.Ordered[int]))
+		})
+	}
+}
+
+func TestFilterNot(t *testing.T) {
+	got := sequences.FilterNot(sequences.NewSequence(1, -2, 3, -4), genfuncs.OrderedGreaterThan(0)).Iterator()
+	assert.Equal(t, -2, got.Next())
+	assert.Equal(t, -4, got.Next())
+	assert.False(t, got.HasNext())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() {
+		_ = got.Next()
+	})
+}
+
+func TestTake(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence container.Sequence[int]
+		n        int
+		want     container.GSlice[int]
+	}{
+		{
+			name:     "Empty",
+			sequence: sequences.NewSequence[int](),
+			n:        2,
+			want:     container.GSlice[int]{},
+		},
+		{
+			name:     "None",
+			sequence: sequences.NewSequence(1, 2, 3),
+			n:        0,
+			want:     container.GSlice[int]{},
+		},
+		{
+			name:     "Some",
+			sequence: sequences.NewSequence(1, 2, 3),
+			n:        2,
+			want:     container.GSlice[int]{1, 2},
+		},
+		{
+			name:     "More Than Available",
+			sequence: sequences.NewSequence(1, 2, 3),
+			n:        5,
+			want:     container.GSlice[int]{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.Take(tt.sequence, tt.n)
+			assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](got, tt.want, genfuncs.Ordered[int]))
+		})
+	}
+}
+
+func TestTake_Negative(t *testing.T) {
+	assert.Panics(t, func() {
+		_ = sequences.Take(sequences.NewSequence(1), -1)
+	})
+}
+
+func TestTakeWhile(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence container.Sequence[int]
+		want     container.GSlice[int]
+	}{
+		{
+			name:     "Empty",
+			sequence: sequences.NewSequence[int](),
+			want:     container.GSlice[int]{},
+		},
+		{
+			name:     "First Fails",
+			sequence: sequences.NewSequence(-1, 2, 3),
+			want:     container.GSlice[int]{},
+		},
+		{
+			name:     "Stops At First Failure",
+			sequence: sequences.NewSequence(1, 2, -3, 4),
+			want:     container.GSlice[int]{1, 2},
+		},
+		{
+			name:     "All",
+			sequence: sequences.NewSequence(1, 2, 3),
+			want:     container.GSlice[int]{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.TakeWhile(tt.sequence, genfuncs.OrderedGreaterThan(0))
+			assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](got, tt.want, genfuncs.Ordered[int]))
+		})
+	}
+}
+
+func TestDrop(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence container.Sequence[int]
+		n        int
+		want     container.GSlice[int]
+	}{
+		{
+			name:     "Empty",
+			sequence: sequences.NewSequence[int](),
+			n:        2,
+			want:     container.GSlice[int]{},
+		},
+		{
+			name:     "None",
+			sequence: sequences.NewSequence(1, 2, 3),
+			n:        0,
+			want:     container.GSlice[int]{1, 2, 3},
+		},
+		{
+			name:     "Some",
+			sequence: sequences.NewSequence(1, 2, 3),
+			n:        2,
+			want:     container.GSlice[int]{3},
+		},
+		{
+			name:     "More Than Available",
+			sequence: sequences.NewSequence(1, 2, 3),
+			n:        5,
+			want:     container.GSlice[int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.Drop(tt.sequence, tt.n)
+			assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](got, tt.want, genfuncs.Ordered[int]))
+		})
+	}
+}
+
+func TestDrop_Negative(t *testing.T) {
+	assert.Panics(t, func() {
+		_ = sequences.Drop(sequences.NewSequence(1), -1)
+	})
+}
+
+func TestDropWhile(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence container.Sequence[int]
+		want     container.GSlice[int]
+	}{
+		{
+			name:     "Empty",
+			sequence: sequences.NewSequence[int](),
+			want:     container.GSlice[int]{},
+		},
+		{
+			name:     "First Fails",
+			sequence: sequences.NewSequence(-1, 2, 3),
+			want:     container.GSlice[int]{-1, 2, 3},
+		},
+		{
+			name:     "Drops Until First Failure",
+			sequence: sequences.NewSequence(1, 2, -3, 4),
+			want:     container.GSlice[int]{-3, 4},
+		},
+		{
+			name:     "All",
+			sequence: sequences.NewSequence(1, 2, 3),
+			want:     container.GSlice[int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.DropWhile(tt.sequence, genfuncs.OrderedGreaterThan(0))
+			assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](got, tt.want, genfuncs.Ordered[int]))
+		})
+	}
+}
+
+func TestWindowed(t *testing.T) {
+	type args struct {
+		size    int
+		step    int
+		partial bool
+	}
+	tests := []struct {
+		name     string
+		sequence container.Sequence[int]
+		args     args
+		want     []container.GSlice[int]
+	}{
+		{
+			name:     "Empty",
+			sequence: sequences.NewSequence[int](),
+			args:     args{size: 2, step: 1},
+			want:     []container.GSlice[int]{},
+		},
+		{
+			name:     "Sliding",
+			sequence: sequences.NewSequence(1, 2, 3, 4),
+			args:     args{size: 3, step: 1},
+			want:     []container.GSlice[int]{{1, 2, 3}, {2, 3, 4}},
+		},
+		{
+			name:     "Sliding Partial",
+			sequence: sequences.NewSequence(1, 2, 3, 4),
+			args:     args{size: 3, step: 1, partial: true},
+			want:     []container.GSlice[int]{{1, 2, 3}, {2, 3, 4}, {3, 4}, {4}},
+		},
+		{
+			name:     "Step Over Size",
+			sequence: sequences.NewSequence(1, 2, 3, 4, 5, 6, 7),
+			args:     args{size: 2, step: 3},
+			want:     []container.GSlice[int]{{1, 2}, {4, 5}},
+		},
+		{
+			name:     "Step Over Size Partial",
+			sequence: sequences.NewSequence(1, 2, 3, 4, 5, 6, 7),
+			args:     args{size: 2, step: 3, partial: true},
+			want:     []container.GSlice[int]{{1, 2}, {4, 5}, {7}},
+		},
+		{
+			name:     "Too Short",
+			sequence: sequences.NewSequence(1, 2),
+			args:     args{size: 3, step: 1},
+			want:     []container.GSlice[int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.Windowed(tt.sequence, tt.args.size, tt.args.step, tt.args.partial)
+			assert.Equal(t, tt.want, toSlice(got))
+		})
+	}
+}
+
+func TestWindowed_IllegalArguments(t *testing.T) {
+	assert.Panics(t, func() {
+		_ = sequences.Windowed(sequences.NewSequence(1), 0, 1, false)
+	})
+	assert.Panics(t, func() {
+		_ = sequences.Windowed(sequences.NewSequence(1), 1, 0, false)
+	})
+}
+
+func TestChunked(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence container.Sequence[int]
+		size     int
+		want     []container.GSlice[int]
+	}{
+		{
+			name:     "Empty",
+			sequence: sequences.NewSequence[int](),
+			size:     2,
+			want:     []container.GSlice[int]{},
+		},
+		{
+			name:     "Even",
+			sequence: sequences.NewSequence(1, 2, 3, 4),
+			size:     2,
+			want:     []container.GSlice[int]{{1, 2}, {3, 4}},
+		},
+		{
+			name:     "Remainder",
+			sequence: container.NewList(1, 2, 3, 4, 5),
+			size:     2,
+			want:     []container.GSlice[int]{{1, 2}, {3, 4}, {5}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.Chunked(tt.sequence, tt.size)
+			assert.Equal(t, tt.want, toSlice(got))
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		name   string
+		first  container.Sequence[int]
+		second container.Sequence[string]
+		want   []genfuncs.Pair[int, string]
+	}{
+		{
+			name:   "Empty",
+			first:  sequences.NewSequence[int](),
+			second: sequences.NewSequence("a"),
+			want:   []genfuncs.Pair[int, string]{},
+		},
+		{
+			name:   "Same Length",
+			first:  sequences.NewSequence(1, 2),
+			second: sequences.NewSequence("a", "b"),
+			want:   []genfuncs.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}},
+		},
+		{
+			name:   "Shortest Wins",
+			first:  sequences.NewSequence(1, 2, 3),
+			second: sequences.NewSequence("a", "b"),
+			want:   []genfuncs.Pair[int, string]{{First: 1, Second: "a"}, {First: 2, Second: "b"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.Zip(tt.first, tt.second)
+			assert.Equal(t, tt.want, toSlice(got))
+		})
+	}
+}
+
+func TestZipWithNext(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence container.Sequence[int]
+		want     []genfuncs.Pair[int, int]
+	}{
+		{
+			name:     "Empty",
+			sequence: sequences.NewSequence[int](),
+			want:     []genfuncs.Pair[int, int]{},
+		},
+		{
+			name:     "One",
+			sequence: sequences.NewSequence(1),
+			want:     []genfuncs.Pair[int, int]{},
+		},
+		{
+			name:     "Three",
+			sequence: sequences.NewSequence(1, 2, 3),
+			want:     []genfuncs.Pair[int, int]{{First: 1, Second: 2}, {First: 2, Second: 3}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.ZipWithNext(tt.sequence)
+			assert.Equal(t, tt.want, toSlice(got))
+			iterator := got.Iterator()
+			for iterator.HasNext() {
+				_ = iterator.Next()
+			}
+			assert.Panics(t, func() { _ = iterator.Next() })
+		})
+	}
+}
+
+func toSlice[T any](sequence container.Sequence[T]) []T {
+	return sequences.Fold(sequence, []T{}, func(s []T, t T) []T { return append(s, t) })
+}
+
+func TestParallelMap(t *testing.T) {
+	var running, maxRunning int32
+	values := make(container.GSlice[int], 100)
+	for i := range values {
+		values[i] = i
+	}
+	transform := func(i int) string {
+		current := atomic.AddInt32(&running, 1)
+		for {
+			highest := atomic.LoadInt32(&maxRunning)
+			if current <= highest || atomic.CompareAndSwapInt32(&maxRunning, highest, current) {
+				break
+			}
+		}
+		time.Sleep(time.Duration(rand.Intn(100)) * time.Microsecond)
+		atomic.AddInt32(&running, -1)
+		return strconv.Itoa(i)
+	}
+	got := sequences.ParallelMap[int, string](context.Background(), values, 4, transform)
+	want := sequences.Map[int, string](values, strconv.Itoa)
+	assert.Equal(t, genfuncs.EqualTo, sequences.Compare[string](got, want, genfuncs.Ordered[string]))
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxRunning), int32(4))
+}
+
+func TestParallelMap_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	got := sequences.ParallelMap[int, int](ctx, sequences.NewSequence(1, 2, 3), 2, func(i int) int {
+		cancel()
+		return i
+	}).Iterator()
+	count := 0
+	for got.HasNext() {
+		_ = got.Next()
+		count++
+	}
+	assert.Less(t, count, 3)
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = got.Next() })
+}
+
+func TestParallelMap_IllegalWorkers(t *testing.T) {
+	assert.Panics(t, func() {
+		_ = sequences.ParallelMap[int, int](context.Background(), sequences.NewSequence(1), 0, func(i int) int { return i })
+	})
+}
+
+func TestParallelMapResult(t *testing.T) {
+	failOn := func(n int) genfuncs.Function[int, *genfuncs.Result[int]] {
+		return func(i int) *genfuncs.Result[int] {
+			if i == n {
+				return genfuncs.NewError[int](fmt.Errorf("failed on %d", i))
+			}
+			return genfuncs.NewResult(i * 2)
+		}
+	}
+	tests := []struct {
+		name      string
+		transform genfuncs.Function[int, *genfuncs.Result[int]]
+		wantOk    container.GSlice[int]
+		wantErr   string
+	}{
+		{
+			name:      "No Errors",
+			transform: failOn(-1),
+			wantOk:    container.GSlice[int]{0, 2, 4, 6, 8, 10, 12, 14, 16, 18},
+		},
+		{
+			name:      "Error",
+			transform: failOn(3),
+			wantOk:    container.GSlice[int]{0, 2, 4},
+			wantErr:   "failed on 3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := sequences.NewSequence(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+			got := toSlice(sequences.ParallelMapResult[int, int](context.Background(), values, 3, tt.transform))
+			var ok container.GSlice[int]
+			var err error
+			for _, r := range got {
+				assert.Nil(t, err, "values after error")
+				r.OnSuccess(func(i int) { ok = append(ok, i) }).OnError(func(e error) { err = e })
+			}
+			assert.Equal(t, tt.wantOk, ok)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestParallelMapResult_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got := toSlice(sequences.ParallelMapResult[int, int](ctx, sequences.NewSequence(1, 2, 3), 2, func(i int) *genfuncs.Result[int] {
+		return genfuncs.NewResult(i)
+	}))
+	assert.Len(t, got, 1)
+	assert.ErrorIs(t, got[0].Error(), context.Canceled)
+}
+
+func TestGenerate(t *testing.T) {
+	count := 0
+	generator := func() *genfuncs.Result[int] {
+		count++
+		if count > 3 {
+			return genfuncs.NewError[int](genfuncs.NoSuchElement)
+		}
+		return genfuncs.NewResult(count)
+	}
+	got := sequences.Generate(generator)
+	assert.Equal(t, []int{1, 2, 3}, toSlice(got))
+	iterator := got.Iterator()
+	assert.False(t, iterator.HasNext())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = iterator.Next() })
+}
+
+func TestIterate(t *testing.T) {
+	powers := sequences.Iterate(1, func(i int) int { return i * 2 })
+	assert.Equal(t, []int{1, 2, 4, 8, 16}, toSlice(sequences.Take(powers, 5)))
+	assert.Equal(t, []int{1, 2}, toSlice(sequences.Take(powers, 2)))
+}
+
+func TestRange(t *testing.T) {
+	type args struct {
+		start int8
+		end   int8
+		step  int8
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int8
+	}{
+		{
+			name: "Empty",
+			args: args{start: 0, end: 0, step: 1},
+			want: []int8{},
+		},
+		{
+			name: "Wrong Direction",
+			args: args{start: 0, end: 5, step: -1},
+			want: []int8{},
+		},
+		{
+			name: "Ascending",
+			args: args{start: 0, end: 5, step: 1},
+			want: []int8{0, 1, 2, 3, 4},
+		},
+		{
+			name: "Ascending By Two",
+			args: args{start: 0, end: 5, step: 2},
+			want: []int8{0, 2, 4},
+		},
+		{
+			name: "Descending",
+			args: args{start: 3, end: -3, step: -2},
+			want: []int8{3, 1, -1},
+		},
+		{
+			name: "Overflow",
+			args: args{start: 120, end: 127, step: 5},
+			want: []int8{120, 125},
+		},
+		{
+			name: "Underflow",
+			args: args{start: -120, end: -128, step: -5},
+			want: []int8{-120, -125},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.Range(tt.args.start, tt.args.end, tt.args.step)
+			assert.Equal(t, tt.want, toSlice(got))
+			assert.Equal(t, tt.want, toSlice(got))
+		})
+	}
+}
+
+func TestRange_ZeroStep(t *testing.T) {
+	assert.Panics(t, func() {
+		_ = sequences.Range(0, 10, 0)
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	assert.Equal(t, []string{}, toSlice(sequences.Repeat("a", 0)))
+	assert.Equal(t, []string{"a", "a", "a"}, toSlice(sequences.Repeat("a", 3)))
+	assert.Panics(t, func() {
+		_ = sequences.Repeat("a", -1)
+	})
+}
+
+func TestCycle(t *testing.T) {
+	tests := []struct {
+		name     string
+		sequence container.Sequence[int]
+		want     []int
+	}{
+		{
+			name:     "Empty",
+			sequence: sequences.NewSequence[int](),
+			want:     []int{},
+		},
+		{
+			name:     "One",
+			sequence: sequences.NewSequence(1),
+			want:     []int{1, 1, 1, 1, 1},
+		},
+		{
+			name:     "Several",
+			sequence: container.NewList(1, 2, 3),
+			want:     []int{1, 2, 3, 1, 2},
+		},
+		{
+			name:     "Once",
+			sequence: sequences.Once[int](container.NewList(1, 2)),
+			want:     []int{1, 2, 1, 2, 1},
+		},
+		{
+			name:     "Iterator",
+			sequence: container.NewIteratorSequence(container.NewList(1, 2, 3).Iterator()),
+			want:     []int{1, 2, 3, 1, 2},
+		},
+		{
+			name:     "Filtered Once",
+			sequence: sequences.Filter(sequences.Once[int](container.NewList(1, 2, 3)), genfuncs.Not(genfuncs.OrderedEqualTo(2))),
+			want:     []int{1, 3, 1, 3, 1},
+		},
+		{
+			name: "Mapped Iterator",
+			sequence: sequences.Map(container.NewIteratorSequence(container.NewList(1, 2).Iterator()),
+				func(i int) int { return i * 10 }),
+			want: []int{10, 20, 10, 20, 10},
+		},
+		{
+			name:     "Empty Once",
+			sequence: sequences.Once(sequences.NewSequence[int]()),
+			want:     []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.Take(sequences.Cycle(tt.sequence), 5)
+			assert.Equal(t, tt.want, toSlice(got))
+		})
+	}
+}
+
+func TestCycle_FromChannel(t *testing.T) {
+	ch := make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	cycle := sequences.Cycle(sequences.FromChannel(context.Background(), ch))
+	assert.Equal(t, []int{1, 2, 1, 2, 1}, toSlice(sequences.Take(cycle, 5)))
+	assert.Equal(t, []int{1, 2, 1}, toSlice(sequences.Take(cycle, 3)))
+
+	ch = make(chan int, 2)
+	ch <- 1
+	ch <- 2
+	close(ch)
+	mapped := sequences.Map(sequences.FromChannel(context.Background(), ch), strconv.Itoa)
+	assert.Equal(t, []string{"1", "2", "1", "2"}, toSlice(sequences.Take(sequences.Cycle(mapped), 4)))
+}
+
+func TestToSeq(t *testing.T) {
+	var got []int
+	for i := range sequences.ToSeq[int](container.NewList(1, 2, 3)) {
+		got = append(got, i)
+	}
+	assert.Equal(t, []int{1, 2, 3}, got)
+	got = nil
+	for i := range sequences.ToSeq(sequences.NewSequence(1, 2, 3)) {
+		if i == 2 {
+			break
+		}
+		got = append(got, i)
+	}
+	assert.Equal(t, []int{1}, got)
+}
+
+func TestFromSeq(t *testing.T) {
+	s := sequences.FromSeq(slices.Values([]string{"a", "b", "c"}))
+	assert.Equal(t, []string{"a", "b", "c"}, toSlice(s))
+	assert.Equal(t, []string{"a", "b", "c"}, toSlice(s))
+	assert.Equal(t, []string{"a"}, toSlice(sequences.Take(s, 1)))
+	iterator := sequences.FromSeq(slices.Values([]string{})).Iterator()
+	assert.False(t, iterator.HasNext())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = iterator.Next() })
+}
+
+func TestFromChannel(t *testing.T) {
+	channel := make(chan int)
+	go func() {
+		defer close(channel)
+		for i := 1; i <= 3; i++ {
+			channel <- i
+		}
+	}()
+	got := sequences.FromChannel(context.Background(), channel)
+	assert.Equal(t, 6, sequences.Fold(got, 0, func(sum, i int) int { return sum + i }))
+	assert.PanicsWithError(t, "illegal state: sequence can only be iterated once", func() { _ = got.Iterator() })
+}
+
+func TestFromChannel_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	channel := make(chan int)
+	go func() {
+		channel <- 1
+		cancel()
+	}()
+	got := sequences.FromChannel(ctx, channel)
+	assert.Equal(t, []int{1}, toSlice(got))
+}
+
+func TestToChannel(t *testing.T) {
+	channel := sequences.ToChannel(context.Background(), sequences.Range(0, 5, 1), 2)
+	var got []int
+	for i := range channel {
+		got = append(got, i)
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, got)
+}
+
+func TestToChannel_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	channel := sequences.ToChannel(ctx, sequences.Iterate(0, func(i int) int { return i + 1 }), 0)
+	assert.Equal(t, 0, <-channel)
+	cancel()
+	for range channel {
+	}
+	_, ok := <-channel
+	assert.False(t, ok)
+}
+
+func TestAssociate_Error(t *testing.T) {
+	failOnBarney := func(p PersonName) *genfuncs.Result[*maps.Entry[string, string]] {
+		if p.First == "barney" {
+			return genfuncs.NewError[*maps.Entry[string, string]](fmt.Errorf("no barneys"))
+		}
+		return genfuncs.NewResult(maps.NewEntry(p.First, p.Last))
+	}
+	names := sequences.NewSequence(
+		PersonName{First: "fred", Last: "flintstone"},
+		PersonName{First: "barney", Last: "rubble"},
+	)
+	result := sequences.Associate(names, failOnBarney)
+	assert.False(t, result.Ok())
+	assert.EqualError(t, result.Error(), "no barneys")
+}
+
+func TestCollectResults(t *testing.T) {
+	failure := fmt.Errorf("failure")
+	tests := []struct {
+		name     string
+		sequence container.Sequence[*genfuncs.Result[int]]
+		want     container.GSlice[int]
+		wantErr  error
+	}{
+		{
+			name:     "Empty",
+			sequence: sequences.NewSequence[*genfuncs.Result[int]](),
+			want:     container.GSlice[int]{},
+		},
+		{
+			name:     "All Ok",
+			sequence: sequences.NewSequence(genfuncs.NewResult(1), genfuncs.NewResult(2)),
+			want:     container.GSlice[int]{1, 2},
+		},
+		{
+			name:     "Error",
+			sequence: sequences.NewSequence(genfuncs.NewResult(1), genfuncs.NewError[int](failure), genfuncs.NewResult(2)),
+			wantErr:  failure,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := sequences.CollectResults(tt.sequence)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, got.Error(), tt.wantErr)
+				return
+			}
+			assert.Equal(t, tt.want, got.MustGet())
+		})
+	}
+}
+
+func TestFilterOk(t *testing.T) {
+	s := sequences.NewSequence(genfuncs.NewResult(1), genfuncs.NewError[int](fmt.Errorf("failure")), genfuncs.NewResult(2))
+	assert.Equal(t, []int{1, 2}, toSlice(sequences.FilterOk(s)))
+}
+
+func TestMapResult(t *testing.T) {
+	failure := fmt.Errorf("failure")
+	s := sequences.NewSequence(genfuncs.NewResult("1"), genfuncs.NewError[string](failure), genfuncs.NewResult("x"))
+	got := toSlice(sequences.MapResult(s, func(s string) *genfuncs.Result[int] {
+		return genfuncs.NewResultError(strconv.Atoi(s))
+	}))
+	assert.Len(t, got, 3)
+	assert.Equal(t, 1, got[0].MustGet())
+	assert.ErrorIs(t, got[1].Error(), failure)
+	assert.ErrorIs(t, got[2].Error(), strconv.ErrSyntax)
+}
+
+func TestSum(t *testing.T) {
+	assert.Equal(t, 0, sequences.Sum(sequences.NewSequence[int]()))
+	assert.Equal(t, 6, sequences.Sum(sequences.NewSequence(1, 2, 3)))
+	assert.InDelta(t, 4.0, sequences.Sum[float64](container.NewList(1.5, 2.5)), 0.0001)
+}
+
+func TestAverage(t *testing.T) {
+	assert.True(t, math.IsNaN(sequences.Average(sequences.NewSequence[int]())))
+	assert.InDelta(t, 2.5, sequences.Average(sequences.NewSequence(1, 2, 3, 4)), 0.0001)
+	assert.InDelta(t, 2.0, sequences.Average(sequences.Range[uint8](1, 4, 1)), 0.0001)
+}
+
+func TestCount(t *testing.T) {
+	assert.Equal(t, 0, sequences.Count(sequences.NewSequence[int](), genfuncs.OrderedGreaterThan(0)))
+	assert.Equal(t, 2, sequences.Count(sequences.NewSequence(-1, 1, -2, 2), genfuncs.OrderedGreaterThan(0)))
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	tests := []struct {
+		name     string
+		sequence container.Sequence[string]
+		wantMin  string
+		wantMax  string
+		wantOk   bool
+	}{
+		{
+			name:     "Empty",
+			sequence: sequences.NewSequence[string](),
+		},
+		{
+			name:     "One",
+			sequence: sequences.NewSequence("a"),
+			wantMin:  "a",
+			wantMax:  "a",
+			wantOk:   true,
+		},
+		{
+			name:     "First Of Ties",
+			sequence: sequences.NewSequence("bb", "a", "ccc", "d", "eee"),
+			wantMin:  "a",
+			wantMax:  "ccc",
+			wantOk:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minimum := sequences.MinBy(tt.sequence, length)
+			maximum := sequences.MaxBy(tt.sequence, length)
+			if !tt.wantOk {
+				assert.ErrorIs(t, minimum.Error(), genfuncs.NoSuchElement)
+				assert.ErrorIs(t, maximum.Error(), genfuncs.NoSuchElement)
+				return
+			}
+			assert.Equal(t, tt.wantMin, minimum.MustGet())
+			assert.Equal(t, tt.wantMax, maximum.MustGet())
+		})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name            string
+		sequence        container.Sequence[int]
+		wantMatching    container.GSlice[int]
+		wantNotMatching container.GSlice[int]
+	}{
+		{
+			name:            "Empty",
+			sequence:        sequences.NewSequence[int](),
+			wantMatching:    container.GSlice[int]{},
+			wantNotMatching: container.GSlice[int]{},
+		},
+		{
+			name:            "Mixed",
+			sequence:        sequences.NewSequence(1, -1, 2, -2, 3),
+			wantMatching:    container.GSlice[int]{1, 2, 3},
+			wantNotMatching: container.GSlice[int]{-1, -2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matching, notMatching := sequences.Partition(tt.sequence, genfuncs.OrderedGreaterThan(0))
+			assert.Equal(t, tt.wantMatching, matching)
+			assert.Equal(t, tt.wantNotMatching, notMatching)
+		})
+	}
+}
+
+func TestReIterable(t *testing.T) {
+	source := container.NewList(1, 2, 3, 4)
+	doubled := sequences.Map(sequences.Filter[int](source, genfuncs.OrderedGreaterThan(1)), func(i int) int { return i * 2 })
+	assert.Equal(t, []int{4, 6, 8}, toSlice(doubled))
+	assert.Equal(t, []int{4, 6, 8}, toSlice(doubled))
+}
+
+func TestOnce(t *testing.T) {
+	once := sequences.Once(sequences.NewSequence(1, 2, 3))
+	assert.Equal(t, []int{1, 2, 3}, toSlice(once))
+	assert.PanicsWithError(t, "illegal state: sequence can only be iterated once", func() {
+		_ = once.Iterator()
+	})
+	mapped := sequences.Map(sequences.Once(sequences.NewSequence(1, 2, 3)), strconv.Itoa)
+	assert.Equal(t, []string{"1", "2", "3"}, toSlice(mapped))
+	assert.Panics(t, func() {
+		_ = toSlice(mapped)
+	})
+}
+
+func TestMemoize(t *testing.T) {
+	fetched := 0
+	source := sequences.Once(sequences.Map(sequences.NewSequence(1, 2, 3), func(i int) int {
+		fetched++
+		return i
+	}))
+	memo := sequences.Memoize(source)
+	assert.Equal(t, 0, fetched)
+	first := memo.Iterator()
+	assert.Equal(t, 1, first.Next())
+	assert.Equal(t, 1, fetched)
+	assert.Equal(t, []int{1, 2, 3}, toSlice(memo))
+	assert.Equal(t, 2, first.Next())
+	assert.Equal(t, 3, first.Next())
+	assert.False(t, first.HasNext())
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = first.Next() })
+	assert.Equal(t, []int{1, 2, 3}, toSlice(memo))
+	assert.Equal(t, 3, fetched)
+}
@@ -0,0 +1,45 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[int] = (*takeIterator[int])(nil)
+
+type takeIterator[T any] struct {
+	iterator  container.Iterator[T]
+	remaining int
+}
+
+func newTakeIterator[T any](sequence container.Sequence[T], n int) container.Iterator[T] {
+	return &takeIterator[T]{iterator: sequence.Iterator(), remaining: n}
+}
+
+func (t *takeIterator[T]) HasNext() bool {
+	return t.remaining > 0 && t.iterator.HasNext()
+}
+
+func (t *takeIterator[T]) Next() T {
+	if !t.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	t.remaining--
+	return t.iterator.Next()
+}
@@ -0,0 +1,60 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[int] = (*takeWhileIterator[int])(nil)
+
+type takeWhileIterator[T any] struct {
+	iterator  container.Iterator[T]
+	predicate genfuncs.Function[T, bool]
+	next      T
+	hasData   bool
+	done      bool
+}
+
+func newTakeWhileIterator[T any](sequence container.Sequence[T], predicate genfuncs.Function[T, bool]) container.Iterator[T] {
+	return &takeWhileIterator[T]{iterator: sequence.Iterator(), predicate: predicate}
+}
+
+func (t *takeWhileIterator[T]) HasNext() bool {
+	if t.hasData {
+		return true
+	}
+	if t.done || !t.iterator.HasNext() {
+		return false
+	}
+	t.next = t.iterator.Next()
+	if !t.predicate(t.next) {
+		t.done = true
+		return false
+	}
+	t.hasData = true
+	return true
+}
+
+func (t *takeWhileIterator[T]) Next() T {
+	if !t.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	t.hasData = false
+	return t.next
+}
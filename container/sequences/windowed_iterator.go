@@ -0,0 +1,86 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[container.GSlice[int]] = (*windowedIterator[int])(nil)
+
+type windowedIterator[T any] struct {
+	iterator container.Iterator[T]
+	size     int
+	step     int
+	partial  bool
+	buffer   container.GSlice[T]
+	skip     int
+	next     container.GSlice[T]
+	hasData  bool
+}
+
+func newWindowedIterator[T any](sequence container.Sequence[T], size, step int, partial bool) container.Iterator[container.GSlice[T]] {
+	return &windowedIterator[T]{
+		iterator: sequence.Iterator(),
+		size:     size,
+		step:     step,
+		partial:  partial,
+		buffer:   make(container.GSlice[T], 0, size),
+	}
+}
+
+func (w *windowedIterator[T]) HasNext() bool {
+	if w.hasData {
+		return true
+	}
+	for w.skip > 0 && w.iterator.HasNext() {
+		w.iterator.Next()
+		w.skip--
+	}
+	for len(w.buffer) < w.size && w.iterator.HasNext() {
+		w.buffer = append(w.buffer, w.iterator.Next())
+	}
+	if len(w.buffer) == 0 || (len(w.buffer) < w.size && !w.partial) {
+		return false
+	}
+	w.next = make(container.GSlice[T], len(w.buffer))
+	copy(w.next, w.buffer)
+	w.hasData = true
+	w.advance()
+	return true
+}
+
+func (w *windowedIterator[T]) Next() container.GSlice[T] {
+	if !w.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	w.hasData = false
+	return w.next
+}
+
+// advance the buffer by step, noting any elements that must be skipped in the source when step exceeds the buffer.
+func (w *windowedIterator[T]) advance() {
+	length := len(w.buffer)
+	if w.step >= length {
+		w.skip = w.step - length
+		w.buffer = w.buffer[:0]
+		return
+	}
+	remaining := copy(w.buffer, w.buffer[w.step:])
+	w.buffer = w.buffer[:remaining]
+}
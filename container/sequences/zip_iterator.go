@@ -0,0 +1,44 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[genfuncs.Pair[int, string]] = (*zipIterator[int, string])(nil)
+
+type zipIterator[A, B any] struct {
+	first  container.Iterator[A]
+	second container.Iterator[B]
+}
+
+func newZipIterator[A, B any](first container.Sequence[A], second container.Sequence[B]) container.Iterator[genfuncs.Pair[A, B]] {
+	return &zipIterator[A, B]{first: first.Iterator(), second: second.Iterator()}
+}
+
+func (z *zipIterator[A, B]) HasNext() bool {
+	return z.first.HasNext() && z.second.HasNext()
+}
+
+func (z *zipIterator[A, B]) Next() genfuncs.Pair[A, B] {
+	if !z.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	return genfuncs.NewPair(z.first.Next(), z.second.Next())
+}
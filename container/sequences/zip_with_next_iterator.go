@@ -0,0 +1,55 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sequences
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+)
+
+var _ container.Iterator[genfuncs.Pair[int, int]] = (*zipWithNextIterator[int])(nil)
+
+type zipWithNextIterator[T any] struct {
+	iterator container.Iterator[T]
+	previous T
+	started  bool
+}
+
+func newZipWithNextIterator[T any](sequence container.Sequence[T]) container.Iterator[genfuncs.Pair[T, T]] {
+	return &zipWithNextIterator[T]{iterator: sequence.Iterator()}
+}
+
+func (z *zipWithNextIterator[T]) HasNext() bool {
+	if !z.started {
+		if !z.iterator.HasNext() {
+			return false
+		}
+		z.previous = z.iterator.Next()
+		z.started = true
+	}
+	return z.iterator.HasNext()
+}
+
+func (z *zipWithNextIterator[T]) Next() genfuncs.Pair[T, T] {
+	if !z.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	current := z.iterator.Next()
+	pair := genfuncs.NewPair(z.previous, current)
+	z.previous = current
+	return pair
+}
@@ -0,0 +1,41 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sets_test
+
+import (
+	"fmt"
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/nwillc/genfuncs/container/sets"
+	"github.com/nwillc/genfuncs/internal/tests"
+	"testing"
+)
+
+func TestFunctionExamples(t *testing.T) {
+	tests.MaybeRunExamples(t)
+	ExampleIntersect()
+}
+
+func ExampleIntersect() {
+	granted := container.NewMapSet("read", "write", "delete")
+	required := container.NewMapSet("read", "write", "admin")
+	fmt.Println(sets.Intersect(granted, required).Values().SortBy(genfuncs.OrderedLess[string]))
+	fmt.Println(sets.IsSubsetOf(required, granted))
+	// Output:
+	// [read write]
+	// false
+}
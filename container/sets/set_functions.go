@@ -0,0 +1,131 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sets
+
+import "github.com/nwillc/genfuncs/container"
+
+// Difference returns a new Set containing the elements of s1 not contained in s2.
+func Difference[T comparable](s1, s2 container.Set[T]) (result container.Set[T]) {
+	result = container.NewMapSet[T]()
+	each(s1, func(t T) bool {
+		if !s2.Contains(t) {
+			result.Add(t)
+		}
+		return true
+	})
+	return result
+}
+
+// Equal returns true if the two Sets contain the same elements.
+func Equal[T comparable](s1, s2 container.Set[T]) (equal bool) {
+	equal = s1.Len() == s2.Len() && containsAll(s2, s1)
+	return equal
+}
+
+// Intersect returns a new Set containing the elements contained in both s1 and s2.
+func Intersect[T comparable](s1, s2 container.Set[T]) (result container.Set[T]) {
+	result = container.NewMapSet[T]()
+	smaller, larger := bySize(s1, s2)
+	each(smaller, func(t T) bool {
+		if larger.Contains(t) {
+			result.Add(t)
+		}
+		return true
+	})
+	return result
+}
+
+// IsDisjoint returns true if s1 and s2 have no elements in common.
+func IsDisjoint[T comparable](s1, s2 container.Set[T]) (disjoint bool) {
+	smaller, larger := bySize(s1, s2)
+	disjoint = true
+	each(smaller, func(t T) bool {
+		disjoint = !larger.Contains(t)
+		return disjoint
+	})
+	return disjoint
+}
+
+// IsSubsetOf returns true if every element of s1 is contained in s2.
+func IsSubsetOf[T comparable](s1, s2 container.Set[T]) (subset bool) {
+	subset = s1.Len() <= s2.Len() && containsAll(s2, s1)
+	return subset
+}
+
+// IsSupersetOf returns true if s1 contains every element of s2.
+func IsSupersetOf[T comparable](s1, s2 container.Set[T]) (superset bool) {
+	superset = IsSubsetOf(s2, s1)
+	return superset
+}
+
+// SymmetricDifference returns a new Set containing the elements contained in exactly one of s1 and s2.
+func SymmetricDifference[T comparable](s1, s2 container.Set[T]) (result container.Set[T]) {
+	result = Difference(s1, s2)
+	each(s2, func(t T) bool {
+		if !s1.Contains(t) {
+			result.Add(t)
+		}
+		return true
+	})
+	return result
+}
+
+// Union returns a new Set containing the elements contained in either s1 or s2.
+func Union[T comparable](s1, s2 container.Set[T]) (result container.Set[T]) {
+	result = container.NewMapSet[T]()
+	for _, s := range []container.Set[T]{s1, s2} {
+		each(s, func(t T) bool {
+			result.Add(t)
+			return true
+		})
+	}
+	return result
+}
+
+func bySize[T comparable](s1, s2 container.Set[T]) (smaller, larger container.Set[T]) {
+	if s1.Len() <= s2.Len() {
+		return s1, s2
+	}
+	return s2, s1
+}
+
+func containsAll[T comparable](s, of container.Set[T]) (all bool) {
+	all = true
+	each(of, func(t T) bool {
+		all = s.Contains(t)
+		return all
+	})
+	return all
+}
+
+// each applies the action to the elements of the Set until it returns false. A MapSet is traversed in place, other
+// Sets by their Iterator.
+func each[T comparable](s container.Set[T], action func(t T) bool) {
+	if mapSet, ok := s.(*container.MapSet[T]); ok {
+		for t := range mapSet.All() {
+			if !action(t) {
+				return
+			}
+		}
+		return
+	}
+	for iterator := s.Iterator(); iterator.HasNext(); {
+		if !action(iterator.Next()) {
+			return
+		}
+	}
+}
@@ -0,0 +1,149 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package sets_test
+
+import (
+	"github.com/nwillc/genfuncs/container"
+	"github.com/nwillc/genfuncs/container/sets"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+// wrappedSet hides a MapSet to exercise the general Set paths.
+type wrappedSet[T comparable] struct {
+	container.Set[T]
+}
+
+func newSets(wrap bool, s1, s2 []int) (container.Set[int], container.Set[int]) {
+	set1, set2 := container.NewMapSet(s1...), container.NewMapSet(s2...)
+	if wrap {
+		return wrappedSet[int]{set1}, wrappedSet[int]{set2}
+	}
+	return set1, set2
+}
+
+func TestSetOperations(t *testing.T) {
+	tests := []struct {
+		name                    string
+		s1, s2                  []int
+		wantUnion               []int
+		wantIntersect           []int
+		wantDifference          []int
+		wantSymmetricDifference []int
+	}{
+		{
+			name:                    "empty",
+			wantUnion:               []int{},
+			wantIntersect:           []int{},
+			wantDifference:          []int{},
+			wantSymmetricDifference: []int{},
+		},
+		{
+			name:                    "overlap",
+			s1:                      []int{1, 2, 3},
+			s2:                      []int{2, 3, 4, 5},
+			wantUnion:               []int{1, 2, 3, 4, 5},
+			wantIntersect:           []int{2, 3},
+			wantDifference:          []int{1},
+			wantSymmetricDifference: []int{1, 4, 5},
+		},
+		{
+			name:                    "disjoint",
+			s1:                      []int{1, 2},
+			s2:                      []int{3},
+			wantUnion:               []int{1, 2, 3},
+			wantIntersect:           []int{},
+			wantDifference:          []int{1, 2},
+			wantSymmetricDifference: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		for _, wrap := range []bool{false, true} {
+			t.Run(tt.name, func(t *testing.T) {
+				s1, s2 := newSets(wrap, tt.s1, tt.s2)
+				assert.ElementsMatch(t, tt.wantUnion, sets.Union(s1, s2).Values())
+				assert.ElementsMatch(t, tt.wantIntersect, sets.Intersect(s1, s2).Values())
+				assert.ElementsMatch(t, tt.wantIntersect, sets.Intersect(s2, s1).Values())
+				assert.ElementsMatch(t, tt.wantDifference, sets.Difference(s1, s2).Values())
+				assert.ElementsMatch(t, tt.wantSymmetricDifference, sets.SymmetricDifference(s1, s2).Values())
+				assert.ElementsMatch(t, tt.wantSymmetricDifference, sets.SymmetricDifference(s2, s1).Values())
+			})
+		}
+	}
+}
+
+func TestSetPredicates(t *testing.T) {
+	tests := []struct {
+		name         string
+		s1, s2       []int
+		wantSubset   bool
+		wantSuperset bool
+		wantDisjoint bool
+		wantEqual    bool
+	}{
+		{
+			name:         "empty",
+			wantSubset:   true,
+			wantSuperset: true,
+			wantDisjoint: true,
+			wantEqual:    true,
+		},
+		{
+			name:       "subset",
+			s1:         []int{1, 2},
+			s2:         []int{1, 2, 3},
+			wantSubset: true,
+		},
+		{
+			name:         "superset",
+			s1:           []int{1, 2, 3},
+			s2:           []int{3},
+			wantSuperset: true,
+		},
+		{
+			name:         "equal",
+			s1:           []int{1, 2, 3},
+			s2:           []int{3, 2, 1},
+			wantSubset:   true,
+			wantSuperset: true,
+			wantEqual:    true,
+		},
+		{
+			name:         "disjoint",
+			s1:           []int{1, 2},
+			s2:           []int{3, 4},
+			wantDisjoint: true,
+		},
+		{
+			name: "same size different",
+			s1:   []int{1, 2},
+			s2:   []int{2, 3},
+		},
+	}
+	for _, tt := range tests {
+		for _, wrap := range []bool{false, true} {
+			t.Run(tt.name, func(t *testing.T) {
+				s1, s2 := newSets(wrap, tt.s1, tt.s2)
+				assert.Equal(t, tt.wantSubset, sets.IsSubsetOf(s1, s2))
+				assert.Equal(t, tt.wantSuperset, sets.IsSupersetOf(s1, s2))
+				assert.Equal(t, tt.wantDisjoint, sets.IsDisjoint(s1, s2))
+				assert.Equal(t, tt.wantEqual, sets.Equal(s1, s2))
+				assert.Equal(t, tt.wantEqual, sets.Equal(s2, s1))
+			})
+		}
+	}
+}
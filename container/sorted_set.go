@@ -0,0 +1,340 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"github.com/nwillc/genfuncs"
+	"iter"
+	"math/rand/v2"
+	"sync"
+)
+
+// SortedSet implements Set.
+var _ Set[int] = (*SortedSet[int])(nil)
+
+const sortedSetMaxLevel = 32
+
+type (
+	// SortedSet is a Set whose elements are ordered by a less than comparator, for example genfuncs.OrderedLess.
+	// Elements are equal when neither is less than the other. SortedSet is an indexable skip list guarded by a
+	// read write lock and is therefore GoRoutine safe. SortedSet implements Set.
+	SortedSet[T comparable] struct {
+		lock   sync.RWMutex
+		less   genfuncs.BiFunction[T, T, bool]
+		head   *sortedSetNode[T]
+		tail   *sortedSetNode[T]
+		level  int
+		length int
+	}
+	sortedSetNode[T comparable] struct {
+		value    T
+		backward *sortedSetNode[T]
+		levels   []sortedSetLevel[T]
+	}
+	// sortedSetLevel is a forward link of a sortedSetNode and the number of elements it spans.
+	sortedSetLevel[T comparable] struct {
+		forward *sortedSetNode[T]
+		span    int
+	}
+)
+
+// NewSortedSet creates a new SortedSet ordered by the less than comparator containing the given values.
+func NewSortedSet[T comparable](less genfuncs.BiFunction[T, T, bool], values ...T) (set *SortedSet[T]) {
+	set = &SortedSet[T]{
+		less:  less,
+		head:  &sortedSetNode[T]{levels: make([]sortedSetLevel[T], sortedSetMaxLevel)},
+		level: 1,
+	}
+	set.AddAll(values...)
+	return set
+}
+
+// Add element to the SortedSet.
+func (s *SortedSet[T]) Add(t T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.insert(t)
+}
+
+// AddAll elements to the SortedSet.
+func (s *SortedSet[T]) AddAll(t ...T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, e := range t {
+		s.insert(e)
+	}
+}
+
+// All returns an iter.Seq of a snapshot of the elements of the SortedSet in ascending order.
+func (s *SortedSet[T]) All() (seq iter.Seq[T]) {
+	values := s.Values()
+	seq = func(yield func(T) bool) {
+		for _, t := range values {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
+// At returns the element at the zero based rank in ascending order, or Result error of NoSuchElement if out of range.
+func (s *SortedSet[T]) At(rank int) (result *genfuncs.Result[T]) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if rank < 0 || rank >= s.length {
+		result = genfuncs.NewError[T](genfuncs.NoSuchElement)
+		return result
+	}
+	target := rank + 1
+	traversed := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && traversed+x.levels[i].span <= target {
+			traversed += x.levels[i].span
+			x = x.levels[i].forward
+		}
+	}
+	result = genfuncs.NewResult(x.value)
+	return result
+}
+
+// Contains returns true if the SortedSet contains the element.
+func (s *SortedSet[T]) Contains(t T) (ok bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	ok = s.find(t) != nil
+	return ok
+}
+
+// First returns the least element, or Result error of NoSuchElement if the SortedSet is empty.
+func (s *SortedSet[T]) First() (result *genfuncs.Result[T]) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	result = sortedSetResult(s.head.levels[0].forward)
+	return result
+}
+
+// Iterator returns an Iterator over a snapshot of the elements of the SortedSet in ascending order.
+func (s *SortedSet[T]) Iterator() Iterator[T] {
+	return s.Values().Iterator()
+}
+
+// Last returns the greatest element, or Result error of NoSuchElement if the SortedSet is empty.
+func (s *SortedSet[T]) Last() (result *genfuncs.Result[T]) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	result = sortedSetResult(s.tail)
+	return result
+}
+
+// Len returns the number of elements in the SortedSet.
+func (s *SortedSet[T]) Len() (length int) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	length = s.length
+	return length
+}
+
+// PopMax removes and returns the greatest element, or Result error of NoSuchElement if the SortedSet is empty.
+func (s *SortedSet[T]) PopMax() (result *genfuncs.Result[T]) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	result = sortedSetResult(s.tail)
+	if s.tail != nil {
+		s.delete(s.tail.value)
+	}
+	return result
+}
+
+// PopMin removes and returns the least element, or Result error of NoSuchElement if the SortedSet is empty.
+func (s *SortedSet[T]) PopMin() (result *genfuncs.Result[T]) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	first := s.head.levels[0].forward
+	result = sortedSetResult(first)
+	if first != nil {
+		s.delete(first.value)
+	}
+	return result
+}
+
+// Range returns a Sequence of a snapshot of the elements of the SortedSet from the element from, inclusive, to the
+// element to, exclusive, in ascending order.
+func (s *SortedSet[T]) Range(from, to T) (sequence Sequence[T]) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	values := GSlice[T]{}
+	for x := s.ceiling(from); x != nil && s.less(x.value, to); x = x.levels[0].forward {
+		values = append(values, x.value)
+	}
+	sequence = values
+	return sequence
+}
+
+// Rank returns the zero based rank of the element in ascending order, or Result error of NoSuchElement if the
+// SortedSet does not contain it.
+func (s *SortedSet[T]) Rank(t T) (result *genfuncs.Result[int]) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	rank := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && !s.less(t, x.levels[i].forward.value) {
+			rank += x.levels[i].span
+			x = x.levels[i].forward
+		}
+		if x != s.head && !s.less(x.value, t) {
+			result = genfuncs.NewResult(rank - 1)
+			return result
+		}
+	}
+	result = genfuncs.NewError[int](genfuncs.NoSuchElement)
+	return result
+}
+
+// Remove an element from the SortedSet.
+func (s *SortedSet[T]) Remove(t T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.delete(t)
+}
+
+// Values returns the elements of the SortedSet in ascending order.
+func (s *SortedSet[T]) Values() (values GSlice[T]) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	values = make(GSlice[T], 0, s.length)
+	for x := s.head.levels[0].forward; x != nil; x = x.levels[0].forward {
+		values = append(values, x.value)
+	}
+	return values
+}
+
+// ceiling returns the node with the least element greater than or equal to t, or nil.
+func (s *SortedSet[T]) ceiling(t T) *sortedSetNode[T] {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && s.less(x.levels[i].forward.value, t) {
+			x = x.levels[i].forward
+		}
+	}
+	return x.levels[0].forward
+}
+
+func (s *SortedSet[T]) delete(t T) {
+	var update [sortedSetMaxLevel]*sortedSetNode[T]
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.levels[i].forward != nil && s.less(x.levels[i].forward.value, t) {
+			x = x.levels[i].forward
+		}
+		update[i] = x
+	}
+	x = x.levels[0].forward
+	if x == nil || s.less(t, x.value) {
+		return
+	}
+	for i := 0; i < s.level; i++ {
+		if update[i].levels[i].forward == x {
+			update[i].levels[i].span += x.levels[i].span - 1
+			update[i].levels[i].forward = x.levels[i].forward
+		} else {
+			update[i].levels[i].span--
+		}
+	}
+	if x.levels[0].forward != nil {
+		x.levels[0].forward.backward = x.backward
+	} else {
+		s.tail = x.backward
+	}
+	for s.level > 1 && s.head.levels[s.level-1].forward == nil {
+		s.level--
+	}
+	s.length--
+}
+
+func (s *SortedSet[T]) find(t T) *sortedSetNode[T] {
+	x := s.ceiling(t)
+	if x == nil || s.less(t, x.value) {
+		return nil
+	}
+	return x
+}
+
+func (s *SortedSet[T]) insert(t T) {
+	var update [sortedSetMaxLevel]*sortedSetNode[T]
+	var rank [sortedSetMaxLevel]int
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.levels[i].forward != nil && s.less(x.levels[i].forward.value, t) {
+			rank[i] += x.levels[i].span
+			x = x.levels[i].forward
+		}
+		update[i] = x
+	}
+	if next := x.levels[0].forward; next != nil && !s.less(t, next.value) {
+		return
+	}
+	level := sortedSetRandomLevel()
+	if level > s.level {
+		for i := s.level; i < level; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			update[i].levels[i].span = s.length
+		}
+		s.level = level
+	}
+	n := &sortedSetNode[T]{value: t, levels: make([]sortedSetLevel[T], level)}
+	for i := 0; i < level; i++ {
+		n.levels[i].forward = update[i].levels[i].forward
+		update[i].levels[i].forward = n
+		n.levels[i].span = update[i].levels[i].span - (rank[0] - rank[i])
+		update[i].levels[i].span = rank[0] - rank[i] + 1
+	}
+	for i := level; i < s.level; i++ {
+		update[i].levels[i].span++
+	}
+	if update[0] != s.head {
+		n.backward = update[0]
+	}
+	if n.levels[0].forward != nil {
+		n.levels[0].forward.backward = n
+	} else {
+		s.tail = n
+	}
+	s.length++
+}
+
+func sortedSetResult[T comparable](n *sortedSetNode[T]) *genfuncs.Result[T] {
+	if n == nil {
+		return genfuncs.NewError[T](genfuncs.NoSuchElement)
+	}
+	return genfuncs.NewResult(n.value)
+}
+
+// sortedSetRandomLevel returns a level with each increment having a probability of one quarter.
+func sortedSetRandomLevel() (level int) {
+	level = 1
+	for level < sortedSetMaxLevel && rand.Uint32()&3 == 0 {
+		level++
+	}
+	return level
+}
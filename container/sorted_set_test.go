@@ -0,0 +1,155 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNewSortedSet(t *testing.T) {
+	s := container.NewSortedSet[int](genfuncs.OrderedLess[int])
+	assert.Equal(t, 0, s.Len())
+	assert.False(t, s.Iterator().HasNext())
+	assert.ErrorIs(t, s.First().Error(), genfuncs.NoSuchElement)
+	assert.ErrorIs(t, s.Last().Error(), genfuncs.NoSuchElement)
+	assert.ErrorIs(t, s.PopMin().Error(), genfuncs.NoSuchElement)
+	assert.ErrorIs(t, s.PopMax().Error(), genfuncs.NoSuchElement)
+	assert.ErrorIs(t, s.At(0).Error(), genfuncs.NoSuchElement)
+	assert.ErrorIs(t, s.Rank(0).Error(), genfuncs.NoSuchElement)
+}
+
+func TestSortedSet_Order(t *testing.T) {
+	tests := []struct {
+		name   string
+		less   genfuncs.BiFunction[int, int, bool]
+		values []int
+		want   []int
+	}{
+		{
+			name:   "ascending",
+			less:   genfuncs.OrderedLess[int],
+			values: []int{5, 1, 4, 1, 3},
+			want:   []int{1, 3, 4, 5},
+		},
+		{
+			name:   "descending",
+			less:   genfuncs.OrderedGreater[int],
+			values: []int{5, 1, 4, 1, 3},
+			want:   []int{5, 4, 3, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := container.NewSortedSet(tt.less, tt.values...)
+			assert.Equal(t, len(tt.want), s.Len())
+			assert.Equal(t, tt.want, []int(s.Values()))
+			assert.Equal(t, tt.want, slices.Collect(s.All()))
+			assert.Equal(t, tt.want[0], s.First().OrEmpty())
+			assert.Equal(t, tt.want[len(tt.want)-1], s.Last().OrEmpty())
+			for i, v := range tt.want {
+				assert.True(t, s.Contains(v))
+				assert.Equal(t, i, s.Rank(v).OrEmpty())
+				assert.Equal(t, v, s.At(i).OrEmpty())
+			}
+			assert.False(t, s.Contains(2))
+		})
+	}
+}
+
+func TestSortedSet_Range(t *testing.T) {
+	s := container.NewSortedSet(genfuncs.OrderedLess[int], 10, 20, 30, 40)
+	tests := []struct {
+		name     string
+		from, to int
+		want     []int
+	}{
+		{name: "all", from: 0, to: 100, want: []int{10, 20, 30, 40}},
+		{name: "inclusive exclusive", from: 20, to: 40, want: []int{20, 30}},
+		{name: "between", from: 11, to: 31, want: []int{20, 30}},
+		{name: "empty", from: 41, to: 50, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for iterator := s.Range(tt.from, tt.to).Iterator(); iterator.HasNext(); {
+				got = append(got, iterator.Next())
+			}
+			assert.ElementsMatch(t, tt.want, got)
+			assert.True(t, slices.IsSorted(got))
+		})
+	}
+}
+
+func TestSortedSet_Pop(t *testing.T) {
+	s := container.NewSortedSet(genfuncs.OrderedLess[int], 3, 1, 2, 4)
+	assert.Equal(t, 1, s.PopMin().OrEmpty())
+	assert.Equal(t, 4, s.PopMax().OrEmpty())
+	assert.Equal(t, []int{2, 3}, []int(s.Values()))
+	assert.Equal(t, 3, s.PopMax().OrEmpty())
+	assert.Equal(t, 2, s.PopMax().OrEmpty())
+	assert.Equal(t, 0, s.Len())
+	assert.ErrorIs(t, s.Last().Error(), genfuncs.NoSuchElement)
+}
+
+func TestSortedSet_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(time.Now().Unix()))
+	s := container.NewSortedSet[int](genfuncs.OrderedLess[int])
+	m := container.NewMapSet[int]()
+	for i := 0; i < 2000; i++ {
+		v := random.Intn(500)
+		if random.Intn(3) == 0 {
+			s.Remove(v)
+			m.Remove(v)
+		} else {
+			s.Add(v)
+			m.Add(v)
+		}
+	}
+	want := m.Values()
+	slices.Sort(want)
+	assert.Equal(t, want, s.Values())
+	for i, v := range want {
+		assert.Equal(t, i, s.Rank(v).OrEmpty())
+		assert.Equal(t, v, s.At(i).OrEmpty())
+	}
+}
+
+func TestSortedSet_Concurrent(t *testing.T) {
+	s := container.NewSortedSet[int](genfuncs.OrderedLess[int])
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				s.Add(w*250 + i)
+				_ = s.Contains(i)
+				_ = s.First()
+			}
+		}(w)
+	}
+	wg.Wait()
+	assert.Equal(t, 1000, s.Len())
+	assert.True(t, slices.IsSorted(s.Values()))
+}
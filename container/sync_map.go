@@ -17,6 +17,7 @@
 package container
 
 import (
+	"iter"
 	"sync"
 )
 
@@ -34,6 +35,17 @@ func NewSyncMap[K any, V any]() (syncMap *SyncMap[K, V]) {
 	return syncMap
 }
 
+// All returns an iter.Seq2 of the keys and values of the SyncMap. The sync.Map's any types are cast to the
+// appropriate types.
+func (s *SyncMap[K, V]) All() (seq iter.Seq2[K, V]) {
+	seq = func(yield func(K, V) bool) {
+		s.m.Range(func(k any, v any) bool {
+			return yield(k.(K), v.(V))
+		})
+	}
+	return seq
+}
+
 // Contains returns true if the Map contains the given key.
 func (s *SyncMap[K, V]) Contains(key K) (contains bool) {
 	_, contains = s.m.Load(key)
@@ -127,3 +127,20 @@ func TestSyncMap_Iterator(t *testing.T) {
 	}
 	assert.Equal(t, count, m.Len())
 }
+
+func TestSyncMap_All(t *testing.T) {
+	syncMap := container.NewSyncMap[int, string]()
+	syncMap.Put(1, "1")
+	syncMap.Put(2, "2")
+	got := make(container.GMap[int, string])
+	for k, v := range syncMap.All() {
+		got[k] = v
+	}
+	assert.Equal(t, container.GMap[int, string]{1: "1", 2: "2"}, got)
+	count := 0
+	for range syncMap.All() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
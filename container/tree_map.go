@@ -0,0 +1,517 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"github.com/nwillc/genfuncs"
+	"iter"
+)
+
+var (
+	// TreeMap implements Map.
+	_ Map[int, int]              = (*TreeMap[int, int])(nil)
+	_ Sequence[*Entry[int, int]] = (*treeMapRange[int, int, *Entry[int, int]])(nil)
+	_ Iterator[*Entry[int, int]] = (*treeMapIterator[int, int, *Entry[int, int]])(nil)
+	_ Iterator[int]              = (*treeMapIterator[int, int, int])(nil)
+)
+
+type (
+	// TreeMap is a Map implementation employing a red-black tree ordered by a less than comparator on its keys. TreeMap
+	// provides ordered iteration and range queries on its keys. TreeMap implements Map.
+	TreeMap[K comparable, V any] struct {
+		root *treeMapNode[K, V]
+		less genfuncs.BiFunction[K, K, bool]
+		len  int
+	}
+	treeMapNode[K comparable, V any] struct {
+		key                 K
+		value               V
+		left, right, parent *treeMapNode[K, V]
+		red                 bool
+	}
+	treeMapRange[K comparable, V, R any] struct {
+		start      func() *treeMapNode[K, V]
+		inRange    genfuncs.Function[K, bool]
+		descending bool
+		get        func(n *treeMapNode[K, V]) R
+	}
+	treeMapIterator[K comparable, V, R any] struct {
+		next       *treeMapNode[K, V]
+		inRange    genfuncs.Function[K, bool]
+		descending bool
+		get        func(n *treeMapNode[K, V]) R
+	}
+)
+
+// NewTreeMap creates a new TreeMap with keys ordered by the less than comparator, for example genfuncs.OrderedLess.
+func NewTreeMap[K comparable, V any](less genfuncs.BiFunction[K, K, bool]) (treeMap *TreeMap[K, V]) {
+	treeMap = &TreeMap[K, V]{less: less}
+	return treeMap
+}
+
+// All returns an iter.Seq2 of the keys and values of the TreeMap in ascending key order.
+func (t *TreeMap[K, V]) All() (seq iter.Seq2[K, V]) {
+	seq = func(yield func(K, V) bool) {
+		for n := t.first(); n != nil; n = n.successor() {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+	return seq
+}
+
+// Ceiling returns the Entry with the least key greater than or equal to the given key, or Result error of
+// NoSuchElement if there is none.
+func (t *TreeMap[K, V]) Ceiling(key K) (result *genfuncs.Result[*Entry[K, V]]) {
+	result = entryResult(t.ceiling(key))
+	return result
+}
+
+// Contains returns true if the TreeMap contains the given key.
+func (t *TreeMap[K, V]) Contains(key K) (contains bool) {
+	contains = t.find(key) != nil
+	return contains
+}
+
+// Delete an entry from the TreeMap.
+func (t *TreeMap[K, V]) Delete(key K) {
+	if n := t.find(key); n != nil {
+		t.delete(n)
+	}
+}
+
+// Descending returns a Sequence of the entries of the TreeMap in descending key order.
+func (t *TreeMap[K, V]) Descending() (sequence Sequence[*Entry[K, V]]) {
+	sequence = &treeMapRange[K, V, *Entry[K, V]]{start: t.last, descending: true, get: nodeEntry[K, V]}
+	return sequence
+}
+
+// Entries returns a Sequence of the entries of the TreeMap in ascending key order.
+func (t *TreeMap[K, V]) Entries() (sequence Sequence[*Entry[K, V]]) {
+	sequence = &treeMapRange[K, V, *Entry[K, V]]{start: t.first, get: nodeEntry[K, V]}
+	return sequence
+}
+
+// First returns the Entry with the least key, or Result error of NoSuchElement if the TreeMap is empty.
+func (t *TreeMap[K, V]) First() (result *genfuncs.Result[*Entry[K, V]]) {
+	result = entryResult(t.first())
+	return result
+}
+
+// Floor returns the Entry with the greatest key less than or equal to the given key, or Result error of NoSuchElement
+// if there is none.
+func (t *TreeMap[K, V]) Floor(key K) (result *genfuncs.Result[*Entry[K, V]]) {
+	result = entryResult(t.floor(key))
+	return result
+}
+
+// ForEach traverses the TreeMap in ascending key order applying the given function to all entries.
+func (t *TreeMap[K, V]) ForEach(f func(key K, value V)) {
+	for n := t.first(); n != nil; n = n.successor() {
+		f(n.key, n.value)
+	}
+}
+
+// Get the value for the key. The returned ok value will be false if the key is not contained in the TreeMap.
+func (t *TreeMap[K, V]) Get(key K) (value V, ok bool) {
+	if n := t.find(key); n != nil {
+		value = n.value
+		ok = true
+	}
+	return value, ok
+}
+
+// Iterator returns an Iterator over the values of the TreeMap in ascending key order.
+func (t *TreeMap[K, V]) Iterator() Iterator[V] {
+	return &treeMapIterator[K, V, V]{next: t.first(), get: nodeValue[K, V]}
+}
+
+// Keys returns the keys of the TreeMap in ascending order.
+func (t *TreeMap[K, V]) Keys() (keys GSlice[K]) {
+	keys = make(GSlice[K], 0, t.len)
+	for n := t.first(); n != nil; n = n.successor() {
+		keys = append(keys, n.key)
+	}
+	return keys
+}
+
+// Last returns the Entry with the greatest key, or Result error of NoSuchElement if the TreeMap is empty.
+func (t *TreeMap[K, V]) Last() (result *genfuncs.Result[*Entry[K, V]]) {
+	result = entryResult(t.last())
+	return result
+}
+
+// Len returns the number of entries in the TreeMap.
+func (t *TreeMap[K, V]) Len() (length int) {
+	length = t.len
+	return length
+}
+
+// Put a key value pair into the TreeMap, replacing the value of an existing key.
+func (t *TreeMap[K, V]) Put(key K, value V) {
+	var parent *treeMapNode[K, V]
+	n := t.root
+	for n != nil {
+		parent = n
+		switch {
+		case t.less(key, n.key):
+			n = n.left
+		case t.less(n.key, key):
+			n = n.right
+		default:
+			n.value = value
+			return
+		}
+	}
+	n = &treeMapNode[K, V]{key: key, value: value, parent: parent, red: true}
+	switch {
+	case parent == nil:
+		t.root = n
+	case t.less(key, parent.key):
+		parent.left = n
+	default:
+		parent.right = n
+	}
+	t.len++
+	t.insertFixup(n)
+}
+
+// Range returns a Sequence of the entries of the TreeMap with keys from the key from, inclusive, to the key to,
+// exclusive, in ascending key order.
+func (t *TreeMap[K, V]) Range(from, to K) (sequence Sequence[*Entry[K, V]]) {
+	sequence = &treeMapRange[K, V, *Entry[K, V]]{
+		start:   func() *treeMapNode[K, V] { return t.ceiling(from) },
+		inRange: func(k K) bool { return t.less(k, to) },
+		get:     nodeEntry[K, V],
+	}
+	return sequence
+}
+
+// Values returns the values of the TreeMap in ascending key order.
+func (t *TreeMap[K, V]) Values() (values GSlice[V]) {
+	values = make(GSlice[V], 0, t.len)
+	for n := t.first(); n != nil; n = n.successor() {
+		values = append(values, n.value)
+	}
+	return values
+}
+
+func (t *TreeMap[K, V]) find(key K) (n *treeMapNode[K, V]) {
+	n = t.root
+	for n != nil {
+		switch {
+		case t.less(key, n.key):
+			n = n.left
+		case t.less(n.key, key):
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+func (t *TreeMap[K, V]) ceiling(key K) (ceiling *treeMapNode[K, V]) {
+	n := t.root
+	for n != nil {
+		switch {
+		case t.less(n.key, key):
+			n = n.right
+		case t.less(key, n.key):
+			ceiling = n
+			n = n.left
+		default:
+			return n
+		}
+	}
+	return ceiling
+}
+
+func (t *TreeMap[K, V]) floor(key K) (floor *treeMapNode[K, V]) {
+	n := t.root
+	for n != nil {
+		switch {
+		case t.less(key, n.key):
+			n = n.left
+		case t.less(n.key, key):
+			floor = n
+			n = n.right
+		default:
+			return n
+		}
+	}
+	return floor
+}
+
+func (t *TreeMap[K, V]) first() (n *treeMapNode[K, V]) {
+	if t.root != nil {
+		n = t.root.minimum()
+	}
+	return n
+}
+
+func (t *TreeMap[K, V]) last() (n *treeMapNode[K, V]) {
+	if t.root != nil {
+		n = t.root.maximum()
+	}
+	return n
+}
+
+func (t *TreeMap[K, V]) insertFixup(n *treeMapNode[K, V]) {
+	for n.parent != nil && n.parent.red {
+		grandparent := n.parent.parent
+		if n.parent == grandparent.left {
+			uncle := grandparent.right
+			if uncle.isRed() {
+				n.parent.red = false
+				uncle.red = false
+				grandparent.red = true
+				n = grandparent
+				continue
+			}
+			if n == n.parent.right {
+				n = n.parent
+				t.rotateLeft(n)
+			}
+			n.parent.red = false
+			n.parent.parent.red = true
+			t.rotateRight(n.parent.parent)
+		} else {
+			uncle := grandparent.left
+			if uncle.isRed() {
+				n.parent.red = false
+				uncle.red = false
+				grandparent.red = true
+				n = grandparent
+				continue
+			}
+			if n == n.parent.left {
+				n = n.parent
+				t.rotateRight(n)
+			}
+			n.parent.red = false
+			n.parent.parent.red = true
+			t.rotateLeft(n.parent.parent)
+		}
+	}
+	t.root.red = false
+}
+
+func (t *TreeMap[K, V]) delete(n *treeMapNode[K, V]) {
+	var child, parent *treeMapNode[K, V]
+	removedRed := n.red
+	switch {
+	case n.left == nil:
+		child = n.right
+		parent = n.parent
+		t.transplant(n, n.right)
+	case n.right == nil:
+		child = n.left
+		parent = n.parent
+		t.transplant(n, n.left)
+	default:
+		successor := n.right.minimum()
+		removedRed = successor.red
+		child = successor.right
+		if successor.parent == n {
+			parent = successor
+		} else {
+			parent = successor.parent
+			t.transplant(successor, successor.right)
+			successor.right = n.right
+			successor.right.parent = successor
+		}
+		t.transplant(n, successor)
+		successor.left = n.left
+		successor.left.parent = successor
+		successor.red = n.red
+	}
+	n.left, n.right, n.parent = nil, nil, nil
+	t.len--
+	if !removedRed {
+		t.deleteFixup(child, parent)
+	}
+}
+
+func (t *TreeMap[K, V]) deleteFixup(n, parent *treeMapNode[K, V]) {
+	for n != t.root && !n.isRed() {
+		if n == parent.left {
+			sibling := parent.right
+			if sibling.isRed() {
+				sibling.red = false
+				parent.red = true
+				t.rotateLeft(parent)
+				sibling = parent.right
+			}
+			if !sibling.left.isRed() && !sibling.right.isRed() {
+				sibling.red = true
+				n = parent
+				parent = n.parent
+				continue
+			}
+			if !sibling.right.isRed() {
+				sibling.left.red = false
+				sibling.red = true
+				t.rotateRight(sibling)
+				sibling = parent.right
+			}
+			sibling.red = parent.red
+			parent.red = false
+			sibling.right.red = false
+			t.rotateLeft(parent)
+		} else {
+			sibling := parent.left
+			if sibling.isRed() {
+				sibling.red = false
+				parent.red = true
+				t.rotateRight(parent)
+				sibling = parent.left
+			}
+			if !sibling.left.isRed() && !sibling.right.isRed() {
+				sibling.red = true
+				n = parent
+				parent = n.parent
+				continue
+			}
+			if !sibling.left.isRed() {
+				sibling.right.red = false
+				sibling.red = true
+				t.rotateLeft(sibling)
+				sibling = parent.left
+			}
+			sibling.red = parent.red
+			parent.red = false
+			sibling.left.red = false
+			t.rotateRight(parent)
+		}
+		n = t.root
+	}
+	if n != nil {
+		n.red = false
+	}
+}
+
+func (t *TreeMap[K, V]) transplant(u, v *treeMapNode[K, V]) {
+	switch {
+	case u.parent == nil:
+		t.root = v
+	case u == u.parent.left:
+		u.parent.left = v
+	default:
+		u.parent.right = v
+	}
+	if v != nil {
+		v.parent = u.parent
+	}
+}
+
+func (t *TreeMap[K, V]) rotateLeft(n *treeMapNode[K, V]) {
+	r := n.right
+	n.right = r.left
+	if r.left != nil {
+		r.left.parent = n
+	}
+	t.transplant(n, r)
+	r.left = n
+	n.parent = r
+}
+
+func (t *TreeMap[K, V]) rotateRight(n *treeMapNode[K, V]) {
+	l := n.left
+	n.left = l.right
+	if l.right != nil {
+		l.right.parent = n
+	}
+	t.transplant(n, l)
+	l.right = n
+	n.parent = l
+}
+
+func (n *treeMapNode[K, V]) isRed() bool {
+	return n != nil && n.red
+}
+
+func (n *treeMapNode[K, V]) minimum() *treeMapNode[K, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (n *treeMapNode[K, V]) maximum() *treeMapNode[K, V] {
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+func (n *treeMapNode[K, V]) successor() *treeMapNode[K, V] {
+	if n.right != nil {
+		return n.right.minimum()
+	}
+	for n.parent != nil && n == n.parent.right {
+		n = n.parent
+	}
+	return n.parent
+}
+
+func (n *treeMapNode[K, V]) predecessor() *treeMapNode[K, V] {
+	if n.left != nil {
+		return n.left.maximum()
+	}
+	for n.parent != nil && n == n.parent.left {
+		n = n.parent
+	}
+	return n.parent
+}
+
+func nodeEntry[K comparable, V any](n *treeMapNode[K, V]) *Entry[K, V] {
+	return NewEntry(n.key, n.value)
+}
+
+func nodeValue[K comparable, V any](n *treeMapNode[K, V]) V {
+	return n.value
+}
+
+func entryResult[K comparable, V any](n *treeMapNode[K, V]) *genfuncs.Result[*Entry[K, V]] {
+	if n == nil {
+		return genfuncs.NewError[*Entry[K, V]](genfuncs.NoSuchElement)
+	}
+	return genfuncs.NewResult(nodeEntry(n))
+}
+
+func (r *treeMapRange[K, V, R]) Iterator() Iterator[R] {
+	return &treeMapIterator[K, V, R]{next: r.start(), inRange: r.inRange, descending: r.descending, get: r.get}
+}
+
+func (i *treeMapIterator[K, V, R]) HasNext() bool {
+	return i.next != nil && (i.inRange == nil || i.inRange(i.next.key))
+}
+
+func (i *treeMapIterator[K, V, R]) Next() (value R) {
+	if !i.HasNext() {
+		panic(genfuncs.NoSuchElement)
+	}
+	value = i.get(i.next)
+	if i.descending {
+		i.next = i.next.predecessor()
+	} else {
+		i.next = i.next.successor()
+	}
+	return value
+}
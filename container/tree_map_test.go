@@ -0,0 +1,180 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"fmt"
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/nwillc/genfuncs/container/sequences"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestNewTreeMap(t *testing.T) {
+	tree := container.NewTreeMap[string, int](genfuncs.OrderedLess[string])
+	assert.NotNil(t, tree)
+	assert.Equal(t, 0, tree.Len())
+	assert.ErrorIs(t, tree.First().Error(), genfuncs.NoSuchElement)
+	assert.ErrorIs(t, tree.Last().Error(), genfuncs.NoSuchElement)
+	assert.False(t, tree.Iterator().HasNext())
+}
+
+func TestTreeMap_PutGetDelete(t *testing.T) {
+	tree := container.NewTreeMap[string, int](genfuncs.OrderedLess[string])
+	tree.Put("b", 2)
+	tree.Put("a", 1)
+	tree.Put("c", 3)
+	assert.Equal(t, 3, tree.Len())
+	v, ok := tree.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+	tree.Put("a", 10)
+	assert.Equal(t, 3, tree.Len())
+	v, ok = tree.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 10, v)
+	tree.Delete("a")
+	tree.Delete("z")
+	assert.Equal(t, 2, tree.Len())
+	assert.False(t, tree.Contains("a"))
+	_, ok = tree.Get("a")
+	assert.False(t, ok)
+}
+
+func TestTreeMap_Ordered(t *testing.T) {
+	tree := container.NewTreeMap[int, string](genfuncs.OrderedGreater[int])
+	for _, k := range []int{3, 1, 4, 5, 9, 2, 6} {
+		tree.Put(k, fmt.Sprint(k))
+	}
+	assert.Equal(t, container.GSlice[int]{9, 6, 5, 4, 3, 2, 1}, tree.Keys())
+	assert.Equal(t, container.GSlice[string]{"9", "6", "5", "4", "3", "2", "1"}, tree.Values())
+	assert.True(t, sequences.IsSorted[string](tree, genfuncs.OrderedGreater[string]))
+	var keys container.GSlice[int]
+	tree.ForEach(func(k int, _ string) { keys = append(keys, k) })
+	assert.Equal(t, tree.Keys(), keys)
+	keys = nil
+	for k := range tree.All() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, tree.Keys(), keys)
+}
+
+func TestTreeMap_Navigation(t *testing.T) {
+	tree := container.NewTreeMap[int, int](genfuncs.OrderedLess[int])
+	for _, k := range []int{10, 20, 30, 40} {
+		tree.Put(k, k*10)
+	}
+	tests := []struct {
+		name string
+		got  *genfuncs.Result[*container.Entry[int, int]]
+		want int
+		ok   bool
+	}{
+		{name: "first", got: tree.First(), want: 10, ok: true},
+		{name: "last", got: tree.Last(), want: 40, ok: true},
+		{name: "floor exact", got: tree.Floor(20), want: 20, ok: true},
+		{name: "floor between", got: tree.Floor(25), want: 20, ok: true},
+		{name: "floor above", got: tree.Floor(99), want: 40, ok: true},
+		{name: "floor below", got: tree.Floor(5), ok: false},
+		{name: "ceiling exact", got: tree.Ceiling(30), want: 30, ok: true},
+		{name: "ceiling between", got: tree.Ceiling(25), want: 30, ok: true},
+		{name: "ceiling below", got: tree.Ceiling(1), want: 10, ok: true},
+		{name: "ceiling above", got: tree.Ceiling(41), ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.ok {
+				assert.ErrorIs(t, tt.got.Error(), genfuncs.NoSuchElement)
+				return
+			}
+			entry := tt.got.MustGet()
+			assert.Equal(t, tt.want, entry.Key)
+			assert.Equal(t, tt.want*10, entry.Value)
+		})
+	}
+}
+
+func TestTreeMap_Range(t *testing.T) {
+	tree := container.NewTreeMap[int, int](genfuncs.OrderedLess[int])
+	for i := 0; i < 10; i++ {
+		tree.Put(i*2, i)
+	}
+	key := func(e *container.Entry[int, int]) int { return e.Key }
+	tests := []struct {
+		name string
+		from int
+		to   int
+		want container.GSlice[int]
+	}{
+		{name: "empty", from: 5, to: 5, want: container.GSlice[int]{}},
+		{name: "inverted", from: 8, to: 2, want: container.GSlice[int]{}},
+		{name: "inclusive exclusive", from: 4, to: 10, want: container.GSlice[int]{4, 6, 8}},
+		{name: "between", from: 3, to: 9, want: container.GSlice[int]{4, 6, 8}},
+		{name: "all", from: -1, to: 100, want: container.GSlice[int]{0, 2, 4, 6, 8, 10, 12, 14, 16, 18}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tree.Range(tt.from, tt.to)
+			want := sequences.NewSequence(tt.want...)
+			assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](sequences.Map(got, key), want, genfuncs.Ordered[int]))
+			assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](sequences.Map(got, key), want, genfuncs.Ordered[int]))
+		})
+	}
+}
+
+func TestTreeMap_EntriesDescending(t *testing.T) {
+	tree := container.NewTreeMap[string, int](genfuncs.OrderedLess[string])
+	tree.Put("b", 2)
+	tree.Put("c", 3)
+	tree.Put("a", 1)
+	value := func(e *container.Entry[string, int]) int { return e.Value }
+	ascending := sequences.Map(tree.Entries(), value)
+	descending := sequences.Map(tree.Descending(), value)
+	assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](ascending, sequences.NewSequence(1, 2, 3), genfuncs.Ordered[int]))
+	assert.Equal(t, genfuncs.EqualTo, sequences.Compare[int](descending, sequences.NewSequence(3, 2, 1), genfuncs.Ordered[int]))
+	iterator := tree.Descending().Iterator()
+	for iterator.HasNext() {
+		_ = iterator.Next()
+	}
+	assert.PanicsWithError(t, genfuncs.NoSuchElement.Error(), func() { _ = iterator.Next() })
+}
+
+func TestTreeMap_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(time.Now().Unix()))
+	tree := container.NewTreeMap[int, int](genfuncs.OrderedLess[int])
+	m := make(container.GMap[int, int])
+	for i := 0; i < 10000; i++ {
+		k := random.Intn(500)
+		if random.Intn(3) == 0 {
+			tree.Delete(k)
+			m.Delete(k)
+		} else {
+			tree.Put(k, i)
+			m.Put(k, i)
+		}
+	}
+	assert.Equal(t, m.Len(), tree.Len())
+	assert.Equal(t, m.Keys().SortBy(genfuncs.OrderedLess[int]), tree.Keys())
+	m.ForEach(func(k, v int) {
+		got, ok := tree.Get(k)
+		assert.True(t, ok)
+		assert.Equal(t, v, got)
+	})
+}
@@ -0,0 +1,272 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container
+
+import (
+	"github.com/nwillc/genfuncs"
+	"iter"
+	"sort"
+	"strings"
+)
+
+var (
+	// Trie implements Map.
+	_ Map[string, int]              = (*Trie[int])(nil)
+	_ Sequence[*Entry[string, int]] = (*trieEntries[int])(nil)
+)
+
+type (
+	// Trie is a Map of string keys held in a radix tree, supporting prefix queries and iteration in lexicographic key
+	// order. Byte slice keys can be used by conversion to string. Trie implements Map.
+	Trie[V any] struct {
+		root   *trieNode[V]
+		length int
+	}
+	// trieNode is reached by the edge prefix from its parent, its children are ordered by the first byte of their
+	// prefixes.
+	trieNode[V any] struct {
+		prefix   string
+		children []*trieNode[V]
+		value    V
+		hasValue bool
+	}
+	trieEntries[V any] struct {
+		trie   *Trie[V]
+		prefix string
+	}
+)
+
+// NewTrie creates a new empty Trie.
+func NewTrie[V any]() (trie *Trie[V]) {
+	trie = &Trie[V]{root: &trieNode[V]{}}
+	return trie
+}
+
+// All returns an iter.Seq2 of the keys and values of the Trie in lexicographic key order.
+func (t *Trie[V]) All() (seq iter.Seq2[string, V]) {
+	seq = func(yield func(string, V) bool) {
+		t.root.walk("", yield)
+	}
+	return seq
+}
+
+// Contains returns true if the Trie contains the given key.
+func (t *Trie[V]) Contains(key string) (contains bool) {
+	_, contains = t.Get(key)
+	return contains
+}
+
+// Delete an entry from the Trie, compacting nodes left with no value and a single child.
+func (t *Trie[V]) Delete(key string) {
+	if t.root.delete(key) {
+		t.length--
+	}
+}
+
+// Entries returns a Sequence of the entries of the Trie in lexicographic key order.
+func (t *Trie[V]) Entries() (entries Sequence[*Entry[string, V]]) {
+	entries = t.WithPrefix("")
+	return entries
+}
+
+// ForEach traverses the Trie in lexicographic key order applying the given function to all entries.
+func (t *Trie[V]) ForEach(f func(key string, value V)) {
+	for k, v := range t.All() {
+		f(k, v)
+	}
+}
+
+// Get the value for the key. The returned ok value will be false if the key is not contained in the Trie.
+func (t *Trie[V]) Get(key string) (value V, ok bool) {
+	n, rest := t.root, key
+	for rest != "" {
+		child, _ := n.child(rest[0])
+		if child == nil || !strings.HasPrefix(rest, child.prefix) {
+			return value, false
+		}
+		n, rest = child, rest[len(child.prefix):]
+	}
+	return n.value, n.hasValue
+}
+
+// Iterator returns an Iterator over the values of the Trie in lexicographic key order.
+func (t *Trie[V]) Iterator() Iterator[V] {
+	return t.Values().Iterator()
+}
+
+// Keys returns the keys of the Trie in lexicographic order.
+func (t *Trie[V]) Keys() (keys GSlice[string]) {
+	keys = make(GSlice[string], 0, t.length)
+	for k := range t.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Len returns the number of entries in the Trie.
+func (t *Trie[V]) Len() (length int) {
+	length = t.length
+	return length
+}
+
+// LongestPrefixOf returns the Entry whose key is the longest prefix of the given key, or Result error of
+// NoSuchElement if there is none.
+func (t *Trie[V]) LongestPrefixOf(key string) (result *genfuncs.Result[*Entry[string, V]]) {
+	result = genfuncs.NewError[*Entry[string, V]](genfuncs.NoSuchElement)
+	n, consumed := t.root, 0
+	for {
+		if n.hasValue {
+			result = genfuncs.NewResult(NewEntry(key[:consumed], n.value))
+		}
+		if consumed == len(key) {
+			return result
+		}
+		child, _ := n.child(key[consumed])
+		if child == nil || !strings.HasPrefix(key[consumed:], child.prefix) {
+			return result
+		}
+		n, consumed = child, consumed+len(child.prefix)
+	}
+}
+
+// Put a key value pair into the Trie, replacing the value of an existing key.
+func (t *Trie[V]) Put(key string, value V) {
+	n, rest := t.root, key
+	for rest != "" {
+		child, i := n.child(rest[0])
+		if child == nil {
+			n.insert(i, &trieNode[V]{prefix: rest, value: value, hasValue: true})
+			t.length++
+			return
+		}
+		common := commonPrefixLength(rest, child.prefix)
+		if common < len(child.prefix) {
+			split := &trieNode[V]{prefix: child.prefix[:common], children: []*trieNode[V]{child}}
+			child.prefix = child.prefix[common:]
+			n.children[i] = split
+			child = split
+		}
+		n, rest = child, rest[common:]
+	}
+	if !n.hasValue {
+		t.length++
+	}
+	n.value, n.hasValue = value, true
+}
+
+// Values returns the values of the Trie in lexicographic key order.
+func (t *Trie[V]) Values() (values GSlice[V]) {
+	values = make(GSlice[V], 0, t.length)
+	for _, v := range t.All() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// WithPrefix returns a Sequence of the entries of the Trie whose keys start with the prefix, in lexicographic key
+// order.
+func (t *Trie[V]) WithPrefix(prefix string) (entries Sequence[*Entry[string, V]]) {
+	entries = &trieEntries[V]{trie: t, prefix: prefix}
+	return entries
+}
+
+// child returns the child whose prefix starts with the byte and its index, or nil and the index to insert it at.
+func (n *trieNode[V]) child(b byte) (*trieNode[V], int) {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].prefix[0] >= b })
+	if i < len(n.children) && n.children[i].prefix[0] == b {
+		return n.children[i], i
+	}
+	return nil, i
+}
+
+// delete the key below the node, returning true if it was present. Children left without a value are removed if
+// they have no children or merged with their only child.
+func (n *trieNode[V]) delete(key string) (deleted bool) {
+	if key == "" {
+		deleted = n.hasValue
+		var zero V
+		n.value, n.hasValue = zero, false
+		return deleted
+	}
+	child, i := n.child(key[0])
+	if child == nil || !strings.HasPrefix(key, child.prefix) {
+		return false
+	}
+	deleted = child.delete(key[len(child.prefix):])
+	if deleted && !child.hasValue {
+		switch len(child.children) {
+		case 0:
+			n.children = append(n.children[:i], n.children[i+1:]...)
+		case 1:
+			only := child.children[0]
+			only.prefix = child.prefix + only.prefix
+			n.children[i] = only
+		}
+	}
+	return deleted
+}
+
+func (n *trieNode[V]) insert(i int, child *trieNode[V]) {
+	n.children = append(n.children, nil)
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = child
+}
+
+// walk yields the entries at and below the node, whose key is given, in lexicographic order until yield returns false.
+func (n *trieNode[V]) walk(key string, yield func(string, V) bool) bool {
+	if n.hasValue && !yield(key, n.value) {
+		return false
+	}
+	for _, child := range n.children {
+		if !child.walk(key+child.prefix, yield) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *trieEntries[V]) Iterator() Iterator[*Entry[string, V]] {
+	entries := GSlice[*Entry[string, V]]{}
+	collect := func(k string, v V) bool {
+		entries = append(entries, NewEntry(k, v))
+		return true
+	}
+	n, key, rest := e.trie.root, "", e.prefix
+	for rest != "" {
+		child, _ := n.child(rest[0])
+		switch {
+		case child == nil:
+			return entries.Iterator()
+		case strings.HasPrefix(rest, child.prefix):
+			rest = rest[len(child.prefix):]
+		case strings.HasPrefix(child.prefix, rest):
+			rest = ""
+		default:
+			return entries.Iterator()
+		}
+		n, key = child, key+child.prefix
+	}
+	n.walk(key, collect)
+	return entries.Iterator()
+}
+
+func commonPrefixLength(a, b string) (length int) {
+	for length < len(a) && length < len(b) && a[length] == b[length] {
+		length++
+	}
+	return length
+}
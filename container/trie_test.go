@@ -0,0 +1,172 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package container_test
+
+import (
+	"github.com/nwillc/genfuncs"
+	"github.com/nwillc/genfuncs/container"
+	"github.com/nwillc/genfuncs/container/maps"
+	"github.com/stretchr/testify/assert"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestTrie(keys ...string) *container.Trie[int] {
+	trie := container.NewTrie[int]()
+	for i, k := range keys {
+		trie.Put(k, i)
+	}
+	return trie
+}
+
+func trieKeys(entries container.Sequence[*maps.Entry[string, int]]) []string {
+	keys := []string{}
+	for iterator := entries.Iterator(); iterator.HasNext(); {
+		keys = append(keys, iterator.Next().Key)
+	}
+	return keys
+}
+
+func TestNewTrie(t *testing.T) {
+	trie := container.NewTrie[int]()
+	assert.Equal(t, 0, trie.Len())
+	assert.False(t, trie.Contains(""))
+	assert.False(t, trie.Iterator().HasNext())
+	assert.ErrorIs(t, trie.LongestPrefixOf("a").Error(), genfuncs.NoSuchElement)
+}
+
+func TestTrie_PutGet(t *testing.T) {
+	trie := newTestTrie("romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus", "", "rom")
+	assert.Equal(t, 9, trie.Len())
+	want := []string{"", "rom", "romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus"}
+	assert.Equal(t, want, []string(trie.Keys()))
+	for _, k := range want {
+		assert.True(t, trie.Contains(k), k)
+	}
+	for _, k := range []string{"r", "ro", "roman", "rubicons", "x"} {
+		assert.False(t, trie.Contains(k), k)
+	}
+	v, ok := trie.Get("rom")
+	assert.True(t, ok)
+	assert.Equal(t, 8, v)
+	trie.Put("rom", 42)
+	v, _ = trie.Get("rom")
+	assert.Equal(t, 42, v)
+	assert.Equal(t, 9, trie.Len())
+	assert.Equal(t, []int{7, 42, 0, 1, 2, 3, 4, 5, 6}, []int(trie.Values()))
+}
+
+func TestTrie_WithPrefix(t *testing.T) {
+	trie := newTestTrie("romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus")
+	tests := []struct {
+		name   string
+		prefix string
+		want   []string
+	}{
+		{name: "all", prefix: "", want: []string{"romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus"}},
+		{name: "edge boundary", prefix: "rom", want: []string{"romane", "romanus", "romulus"}},
+		{name: "mid edge", prefix: "roma", want: []string{"romane", "romanus"}},
+		{name: "exact key", prefix: "ruber", want: []string{"ruber"}},
+		{name: "mismatch mid edge", prefix: "romx", want: []string{}},
+		{name: "past key", prefix: "rubers", want: []string{}},
+		{name: "none", prefix: "x", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, trieKeys(trie.WithPrefix(tt.prefix)))
+		})
+	}
+	assert.Equal(t, tests[0].want, trieKeys(trie.Entries()))
+}
+
+func TestTrie_LongestPrefixOf(t *testing.T) {
+	trie := newTestTrie("/", "/api", "/api/v1/users", "/static")
+	tests := []struct {
+		key     string
+		want    string
+		wantErr bool
+	}{
+		{key: "/api/v1/users/42", want: "/api/v1/users"},
+		{key: "/api/v1", want: "/api"},
+		{key: "/api", want: "/api"},
+		{key: "/apix", want: "/api"},
+		{key: "/other", want: "/"},
+		{key: "other", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			result := trie.LongestPrefixOf(tt.key)
+			if tt.wantErr {
+				assert.ErrorIs(t, result.Error(), genfuncs.NoSuchElement)
+				return
+			}
+			assert.Equal(t, tt.want, result.MustGet().Key)
+		})
+	}
+}
+
+func TestTrie_Delete(t *testing.T) {
+	trie := newTestTrie("test", "team", "toast", "te")
+	trie.Delete("t")
+	trie.Delete("tes")
+	trie.Delete("tests")
+	assert.Equal(t, 4, trie.Len())
+	trie.Delete("te")
+	assert.Equal(t, []string{"team", "test", "toast"}, []string(trie.Keys()))
+	trie.Delete("team")
+	assert.Equal(t, []string{"test", "toast"}, []string(trie.Keys()))
+	assert.Equal(t, []string{"test"}, trieKeys(trie.WithPrefix("te")))
+	trie.Put("tea", 1)
+	assert.Equal(t, []string{"tea", "test"}, trieKeys(trie.WithPrefix("te")))
+	trie.Delete("test")
+	trie.Delete("tea")
+	trie.Delete("toast")
+	assert.Equal(t, 0, trie.Len())
+	assert.Equal(t, []string{}, trieKeys(trie.Entries()))
+}
+
+func TestTrie_Random(t *testing.T) {
+	random := rand.New(rand.NewSource(time.Now().Unix()))
+	trie := container.NewTrie[int]()
+	m := container.GMap[string, int]{}
+	for i := 0; i < 2000; i++ {
+		var sb strings.Builder
+		for j := random.Intn(6); j >= 0; j-- {
+			sb.WriteByte(byte('a' + random.Intn(3)))
+		}
+		k := sb.String()
+		if random.Intn(3) == 0 {
+			trie.Delete(k)
+			m.Delete(k)
+		} else {
+			trie.Put(k, i)
+			m.Put(k, i)
+		}
+	}
+	assert.Equal(t, m.Len(), trie.Len())
+	keys := m.Keys()
+	slices.Sort(keys)
+	assert.Equal(t, keys, trie.Keys())
+	trie.ForEach(func(k string, v int) {
+		assert.Equal(t, m[k], v)
+	})
+	lengths := maps.Map[string, int, int](trie, func(k string, _ int) int { return len(k) })
+	assert.Equal(t, trie.Len(), lengths.Len())
+}
@@ -21,3 +21,6 @@ import "fmt"
 // NoSuchElement error is used by panics when attempts are made to access out of bounds.
 var NoSuchElement = fmt.Errorf("no such element")
 var IllegalArguments = fmt.Errorf("illegal arguments")
+
+// IllegalState error is used by panics when an operation is attempted that the current state does not permit.
+var IllegalState = fmt.Errorf("illegal state")
@@ -16,6 +16,8 @@
 
 package genfuncs
 
+import "golang.org/x/exp/constraints"
+
 // BiFunction accepts two arguments and produces a result.
 type BiFunction[T, U, R any] func(T, U) R
 
@@ -24,3 +26,8 @@ type Function[T, R any] func(T) R
 
 // ToString is used to create string representations, it accepts any type and returns a string.
 type ToString[T any] func(T) string
+
+// Number is a constraint for the integer and floating point types.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
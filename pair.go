@@ -0,0 +1,40 @@
+/*
+ *  Copyright (c) 2022,  nwillc@gmail.com
+ *
+ *  Permission to use, copy, modify, and/or distribute this software for any
+ *  purpose with or without fee is hereby granted, provided that the above
+ *  copyright notice and this permission notice appear in all copies.
+ *
+ *  THE SOFTWARE IS PROVIDED "AS IS" AND THE AUTHOR DISCLAIMS ALL WARRANTIES
+ *  WITH REGARD TO THIS SOFTWARE INCLUDING ALL IMPLIED WARRANTIES OF
+ *  MERCHANTABILITY AND FITNESS. IN NO EVENT SHALL THE AUTHOR BE LIABLE FOR
+ *  ANY SPECIAL, DIRECT, INDIRECT, OR CONSEQUENTIAL DAMAGES OR ANY DAMAGES
+ *  WHATSOEVER RESULTING FROM LOSS OF USE, DATA OR PROFITS, WHETHER IN AN
+ *  ACTION OF CONTRACT, NEGLIGENCE OR OTHER TORTIOUS ACTION, ARISING OUT OF
+ *  OR IN CONNECTION WITH THE USE OR PERFORMANCE OF THIS SOFTWARE.
+ */
+
+package genfuncs
+
+import "fmt"
+
+// Pair implements fmt.Stringer.
+var _ fmt.Stringer = (*Pair[int, int])(nil)
+
+// Pair holds two values of possibly differing types, the First and the Second.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair creates a Pair of the two values.
+func NewPair[A, B any](first A, second B) (pair Pair[A, B]) {
+	pair = Pair[A, B]{First: first, Second: second}
+	return pair
+}
+
+// String returns a string representation of the Pair.
+func (p Pair[A, B]) String() (str string) {
+	str = fmt.Sprintf("(%v, %v)", p.First, p.Second)
+	return str
+}
@@ -18,6 +18,7 @@ package genfuncs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 )
@@ -41,7 +42,7 @@ var (
 // NewPromise creates a Promise for an action.
 func NewPromise[T any](ctx context.Context, action func(context.Context) *Result[T]) *Promise[T] {
 	if action == nil {
-		return NewPromiseFromResult(NewError[T](fmt.Errorf(PromiseNoActionErrorMsg)))
+		return NewPromiseFromResult(NewError[T](errors.New(PromiseNoActionErrorMsg)))
 	}
 	pctx, cancel := context.WithCancel(ctx)
 	p := &Promise[T]{
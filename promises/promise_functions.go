@@ -18,7 +18,7 @@ package promises
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"github.com/nwillc/genfuncs"
 	"github.com/nwillc/genfuncs/container"
 	"github.com/nwillc/genfuncs/results"
@@ -70,7 +70,7 @@ func All[T any](ctx context.Context, promises ...*genfuncs.Promise[T]) *genfuncs
 func Any[T any](ctx context.Context, promises ...*genfuncs.Promise[T]) *genfuncs.Promise[T] {
 	count := len(promises)
 	if count == 0 {
-		return genfuncs.NewPromiseFromResult(genfuncs.NewError[T](fmt.Errorf(PromiseAnyNoPromisesErrorMsg)))
+		return genfuncs.NewPromiseFromResult(genfuncs.NewError[T](errors.New(PromiseAnyNoPromisesErrorMsg)))
 	}
 	return genfuncs.NewPromise(
 		ctx,
@@ -91,7 +91,7 @@ func Any[T any](ctx context.Context, promises ...*genfuncs.Promise[T]) *genfuncs
 					}
 				}
 			}
-			return genfuncs.NewError[T](fmt.Errorf(PromiseNoneFulfilled))
+			return genfuncs.NewError[T](errors.New(PromiseNoneFulfilled))
 		})
 }
 